@@ -13,5 +13,10 @@ func sameTableName(n *ast.TableName, f core.FQN, defaultSchema string) bool {
 	if n.Schema == "" {
 		schema = defaultSchema
 	}
-	return n.Catalog == f.Catalog && schema == f.Schema && n.Name == f.Rel
+	// An override naming only the table applies to the default schema
+	fschema := f.Schema
+	if fschema == "" {
+		fschema = defaultSchema
+	}
+	return n.Catalog == f.Catalog && schema == fschema && n.Name == f.Rel
 }
@@ -0,0 +1,32 @@
+package golang
+
+// A Composite is a Go struct for a PostgreSQL composite type. It's scanned
+// from and converted to the text format of a row, such as `(1,"a b",)`.
+//
+// https://www.postgresql.org/docs/current/rowtypes.html#ROWTYPES-IO-SYNTAX
+type Composite struct {
+	Name    string
+	Comment string
+	Fields  []CompositeField
+}
+
+type CompositeField struct {
+	Field
+	IsArray bool
+}
+
+// ScanArg is the destination a field of the composite is scanned into.
+func (f CompositeField) ScanArg() string {
+	if f.IsArray {
+		return "pq.Array(&c." + f.Name + ")"
+	}
+	return "&c." + f.Name
+}
+
+// ValueArg is the value a field of the composite is converted from.
+func (f CompositeField) ValueArg() string {
+	if f.IsArray {
+		return "pq.Array(c." + f.Name + ")"
+	}
+	return "c." + f.Name
+}
@@ -17,6 +17,22 @@ type Enum struct {
 	Name      string
 	Comment   string
 	Constants []Constant
+
+	// SetName is the name of the slice type of a MySQL SET column, whose
+	// values hold any number of the enum's values
+	SetName string
+	// NullSetName is the name of the struct for a SET that may be NULL, set
+	// when a nullable SET column uses it
+	NullSetName string
+}
+
+// usedNullSets names the structs for the SET types of nullable columns.
+func usedNullSets(enums []Enum, used map[string]bool) {
+	for i := range enums {
+		if enums[i].SetName != "" && used["Null"+enums[i].SetName] {
+			enums[i].NullSetName = "Null" + enums[i].SetName
+		}
+	}
 }
 
 func EnumReplace(value string) string {
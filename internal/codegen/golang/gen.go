@@ -160,6 +160,9 @@ type Querier interface {
 	{{- if eq .Cmd ":execrows"}}
 	{{.MethodName}}(ctx context.Context, {{.Arg.Pair}}) (int64, error)
 	{{- end}}
+	{{- if eq .Cmd ":execlastid"}}
+	{{.MethodName}}(ctx context.Context, {{.Arg.Pair}}) (int64, error)
+	{{- end}}
 	{{- if eq .Cmd ":execresult"}}
 	{{.MethodName}}(ctx context.Context, {{.Arg.Pair}}) (sql.Result, error)
 	{{- end}}
@@ -205,6 +208,654 @@ func (e *{{.Name}}) Scan(src interface{}) error {
 	}
 	return nil
 }
+{{if .SetName}}
+type {{.SetName}} []{{.Name}}
+
+func (s *{{.SetName}}) Scan(src interface{}) error {
+	var list string
+	switch v := src.(type) {
+	case []byte:
+		list = string(v)
+	case string:
+		list = v
+	case nil:
+		*s = nil
+		return nil
+	default:
+		return fmt.Errorf("unsupported scan type for {{.SetName}}: %T", src)
+	}
+	*s = {{.SetName}}{}
+	if list == "" {
+		return nil
+	}
+	for _, v := range strings.Split(list, ",") {
+		*s = append(*s, {{.Name}}(v))
+	}
+	return nil
+}
+
+func (s {{.SetName}}) Value() (driver.Value, error) {
+	vals := make([]string, len(s))
+	for i := range s {
+		vals[i] = string(s[i])
+	}
+	return strings.Join(vals, ","), nil
+}
+{{end}}
+{{- if .NullSetName}}
+// {{.NullSetName}} is a {{.SetName}} that may be NULL.
+type {{.NullSetName}} struct {
+	Set   {{.SetName}}
+	Valid bool
+}
+
+func (n *{{.NullSetName}}) Scan(src interface{}) error {
+	if src == nil {
+		*n = {{.NullSetName}}{}
+		return nil
+	}
+	n.Valid = true
+	return n.Set.Scan(src)
+}
+
+func (n {{.NullSetName}}) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Set.Value()
+}
+{{end}}
+{{end}}
+
+{{range .Composites}}
+{{if .Comment}}{{comment .Comment}}{{end}}
+type {{.Name}} struct { {{- range .Fields}}
+  {{- if .Comment}}
+  {{comment .Comment}}{{else}}
+  {{- end}}
+  {{.Name}} {{.Type}} {{if or ($.EmitJSONTags) ($.EmitDBTags)}}{{$.Q}}{{.Tag}}{{$.Q}}{{end}}
+  {{- end}}
+}
+
+func (c *{{.Name}}) Scan(src interface{}) error {
+	var literal string
+	switch s := src.(type) {
+	case []byte:
+		literal = string(s)
+	case string:
+		literal = s
+	case nil:
+		*c = {{.Name}}{}
+		return nil
+	default:
+		return fmt.Errorf("unsupported scan type for {{.Name}}: %T", src)
+	}
+	fields, err := parseCompositeLiteral(literal)
+	if err != nil {
+		return err
+	}
+	if len(fields) != {{len .Fields}} {
+		return fmt.Errorf("{{.Name}}: expected {{len .Fields}} fields, got %d", len(fields))
+	}
+	*c = {{.Name}}{}
+	{{- range $i, $f := .Fields}}
+	if err := scanTextField({{$f.ScanArg}}, fields[{{$i}}]); err != nil {
+		return fmt.Errorf("{{$f.Name}}: %w", err)
+	}
+	{{- end}}
+	return nil
+}
+
+func (c {{.Name}}) Value() (driver.Value, error) {
+	return compositeLiteral({{range $i, $f := .Fields}}{{if $i}}, {{end}}{{$f.ValueArg}}{{end}})
+}
+{{end}}
+
+{{range .Ranges}}
+{{if .Comment}}{{comment .Comment}}{{end}}
+type {{.Name}} struct {
+	Lower          {{.BoundType}}
+	Upper          {{.BoundType}}
+	LowerInclusive bool
+	UpperInclusive bool
+	{{- if .Infinity}}
+	// LowerInfinity and UpperInfinity are -1 when the bound is -infinity and
+	// 1 when it's infinity, in which case Lower or Upper is ignored
+	LowerInfinity int
+	UpperInfinity int
+	{{- end}}
+	Empty bool
+}
+
+func (r *{{.Name}}) Scan(src interface{}) error {
+	var literal string
+	switch s := src.(type) {
+	case []byte:
+		literal = string(s)
+	case string:
+		literal = s
+	case nil:
+		*r = {{.Name}}{}
+		return nil
+	default:
+		return fmt.Errorf("unsupported scan type for {{.Name}}: %T", src)
+	}
+	bounds, lowerInclusive, upperInclusive, err := parseRangeLiteral(literal)
+	if err != nil {
+		return err
+	}
+	*r = {{.Name}}{LowerInclusive: lowerInclusive, UpperInclusive: upperInclusive, Empty: bounds == nil}
+	if r.Empty {
+		return nil
+	}
+	{{- if .Infinity}}
+	if r.LowerInfinity = rangeInfinity(bounds[0]); r.LowerInfinity != 0 {
+		bounds[0] = nil
+	}
+	if r.UpperInfinity = rangeInfinity(bounds[1]); r.UpperInfinity != 0 {
+		bounds[1] = nil
+	}
+	{{- end}}
+	if err := scanTextField(&r.Lower, bounds[0]); err != nil {
+		return fmt.Errorf("Lower: %w", err)
+	}
+	if err := scanTextField(&r.Upper, bounds[1]); err != nil {
+		return fmt.Errorf("Upper: %w", err)
+	}
+	return nil
+}
+
+func (r {{.Name}}) Value() (driver.Value, error) {
+	{{- if .Infinity}}
+	return rangeLiteral(rangeBound(r.Lower, r.LowerInfinity), rangeBound(r.Upper, r.UpperInfinity), r.LowerInclusive, r.UpperInclusive, r.Empty)
+	{{- else}}
+	return rangeLiteral(r.Lower, r.Upper, r.LowerInclusive, r.UpperInclusive, r.Empty)
+	{{- end}}
+}
+{{if .NullName}}
+// {{.NullName}} is a {{.Name}} that may be NULL.
+type {{.NullName}} struct {
+	Range {{.Name}}
+	Valid bool
+}
+
+func (n *{{.NullName}}) Scan(src interface{}) error {
+	if src == nil {
+		*n = {{.NullName}}{}
+		return nil
+	}
+	n.Valid = true
+	return n.Range.Scan(src)
+}
+
+func (n {{.NullName}}) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Range.Value()
+}
+{{end}}
+{{end}}
+
+{{range .Multiranges}}
+{{if .Comment}}{{comment .Comment}}{{end}}
+type {{.Name}} []{{.Range}}
+
+func (m *{{.Name}}) Scan(src interface{}) error {
+	var literal string
+	switch s := src.(type) {
+	case []byte:
+		literal = string(s)
+	case string:
+		literal = s
+	case nil:
+		*m = nil
+		return nil
+	default:
+		return fmt.Errorf("unsupported scan type for {{.Name}}: %T", src)
+	}
+	ranges, err := parseMultirangeLiteral(literal)
+	if err != nil {
+		return err
+	}
+	*m = make({{.Name}}, len(ranges))
+	for i, r := range ranges {
+		if err := (*m)[i].Scan(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m {{.Name}}) Value() (driver.Value, error) {
+	if m == nil {
+		return nil, nil
+	}
+	ranges := make([]driver.Valuer, len(m))
+	for i := range m {
+		ranges[i] = m[i]
+	}
+	return multirangeLiteral(ranges)
+}
+{{end}}
+
+{{if .Interval}}
+// {{.Interval}} is a PostgreSQL interval. Months and days are kept apart from the
+// time of day because their length varies with the date they're added to.
+type {{.Interval}} struct {
+	Months       int32
+	Days         int32
+	Microseconds int64
+}
+
+// Duration returns the interval as a time.Duration. It reports false if the
+// interval has months or days, which have no fixed length.
+func (i {{.Interval}}) Duration() (time.Duration, bool) {
+	if i.Months != 0 || i.Days != 0 {
+		return 0, false
+	}
+	return time.Duration(i.Microseconds) * time.Microsecond, true
+}
+
+func (i *{{.Interval}}) Scan(src interface{}) error {
+	var literal string
+	switch s := src.(type) {
+	case []byte:
+		literal = string(s)
+	case string:
+		literal = s
+	case nil:
+		*i = {{.Interval}}{}
+		return nil
+	default:
+		return fmt.Errorf("unsupported scan type for {{.Interval}}: %T", src)
+	}
+	v, err := pginterval.Parse(literal)
+	if err != nil {
+		return err
+	}
+	*i = {{.Interval}}(v)
+	return nil
+}
+
+func (i {{.Interval}}) Value() (driver.Value, error) {
+	return fmt.Sprintf("%d months %d days %d microseconds", i.Months, i.Days, i.Microseconds), nil
+}
+{{end}}
+
+{{if .NullInterval}}
+// {{.NullInterval}} is an interval that may be NULL.
+type {{.NullInterval}} struct {
+	Interval {{.Interval}}
+	Valid    bool
+}
+
+func (n *{{.NullInterval}}) Scan(src interface{}) error {
+	if src == nil {
+		*n = {{.NullInterval}}{}
+		return nil
+	}
+	n.Valid = true
+	return n.Interval.Scan(src)
+}
+
+func (n {{.NullInterval}}) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Interval.Value()
+}
+{{end}}
+
+{{if .NullUint64}}
+// NullUint64 is a uint64 that may be NULL, such as the value of a nullable
+// BIGINT UNSIGNED column.
+type NullUint64 struct {
+	Uint64 uint64
+	Valid  bool
+}
+
+func (n *NullUint64) Scan(src interface{}) error {
+	var err error
+	switch v := src.(type) {
+	case nil:
+		*n = NullUint64{}
+		return nil
+	case uint64:
+		n.Uint64 = v
+	case int64:
+		if v < 0 {
+			return fmt.Errorf("NullUint64: %d is negative", v)
+		}
+		n.Uint64 = uint64(v)
+	case []byte:
+		n.Uint64, err = strconv.ParseUint(string(v), 10, 64)
+	case string:
+		n.Uint64, err = strconv.ParseUint(v, 10, 64)
+	default:
+		return fmt.Errorf("unsupported scan type for NullUint64: %T", src)
+	}
+	n.Valid = err == nil
+	return err
+}
+
+func (n NullUint64) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	// Values with the high bit set can't be passed as an int64
+	if n.Uint64 >= 1<<63 {
+		return strconv.FormatUint(n.Uint64, 10), nil
+	}
+	return int64(n.Uint64), nil
+}
+{{end}}
+
+{{if .Composites}}
+// parseCompositeLiteral splits the text format of a composite value into its
+// fields. A nil field is NULL.
+func parseCompositeLiteral(s string) ([]*string, error) {
+	if len(s) < 2 || s[0] != '(' || s[len(s)-1] != ')' {
+		return nil, fmt.Errorf("invalid composite literal: %q", s)
+	}
+	return splitTextFields(s[1 : len(s)-1]), nil
+}
+
+// compositeLiteral returns the text format of a composite value.
+func compositeLiteral(fields ...interface{}) (driver.Value, error) {
+	var b strings.Builder
+	b.WriteByte('(')
+	for i, field := range fields {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		if err := writeTextField(&b, field); err != nil {
+			return nil, err
+		}
+	}
+	b.WriteByte(')')
+	return b.String(), nil
+}
+{{end}}
+
+{{if .Ranges}}
+// parseRangeLiteral splits the text format of a range, such as "[1,10)", into
+// its bounds. A nil bound is unbounded; nil bounds mean the range is empty.
+func parseRangeLiteral(s string) ([]*string, bool, bool, error) {
+	if s == "empty" {
+		return nil, false, false, nil
+	}
+	if len(s) < 2 || (s[0] != '[' && s[0] != '(') || (s[len(s)-1] != ']' && s[len(s)-1] != ')') {
+		return nil, false, false, fmt.Errorf("invalid range literal: %q", s)
+	}
+	bounds := splitTextFields(s[1 : len(s)-1])
+	if len(bounds) != 2 {
+		return nil, false, false, fmt.Errorf("invalid range literal: %q", s)
+	}
+	return bounds, s[0] == '[', s[len(s)-1] == ']', nil
+}
+
+// rangeInfinity returns -1 if a bound is -infinity, 1 if it's infinity, and 0
+// otherwise.
+func rangeInfinity(bound *string) int {
+	switch {
+	case bound == nil:
+		return 0
+	case strings.EqualFold(*bound, "-infinity"):
+		return -1
+	case strings.EqualFold(*bound, "infinity"):
+		return 1
+	}
+	return 0
+}
+
+// rangeBound returns the bound of a range, or infinity or -infinity in its
+// place.
+func rangeBound(bound interface{}, infinity int) interface{} {
+	switch {
+	case infinity < 0:
+		return "-infinity"
+	case infinity > 0:
+		return "infinity"
+	}
+	return bound
+}
+
+// rangeLiteral returns the text format of a range. A NULL bound is unbounded.
+func rangeLiteral(lower, upper interface{}, lowerInclusive, upperInclusive, empty bool) (driver.Value, error) {
+	if empty {
+		return "empty", nil
+	}
+	var b strings.Builder
+	if lowerInclusive {
+		b.WriteByte('[')
+	} else {
+		b.WriteByte('(')
+	}
+	if err := writeTextField(&b, lower); err != nil {
+		return nil, err
+	}
+	b.WriteByte(',')
+	if err := writeTextField(&b, upper); err != nil {
+		return nil, err
+	}
+	if upperInclusive {
+		b.WriteByte(']')
+	} else {
+		b.WriteByte(')')
+	}
+	return b.String(), nil
+}
+{{end}}
+
+{{if .Multiranges}}
+// parseMultirangeLiteral splits the text format of a multirange, such as
+// "{[1,3),[5,7)}", into the text format of its ranges.
+func parseMultirangeLiteral(s string) ([]string, error) {
+	if len(s) < 2 || s[0] != '{' || s[len(s)-1] != '}' {
+		return nil, fmt.Errorf("invalid multirange literal: %q", s)
+	}
+	s = s[1 : len(s)-1]
+	var ranges []string
+	start, depth, quoted := 0, 0, false
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '\\':
+			i++
+		case c == '"':
+			quoted = !quoted
+		case quoted:
+		case c == '[' || c == '(':
+			depth++
+		case c == ']' || c == ')':
+			depth--
+		case c == ',':
+			if depth == 0 {
+				ranges = append(ranges, strings.TrimSpace(s[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	if strings.TrimSpace(s) != "" {
+		ranges = append(ranges, strings.TrimSpace(s[start:]))
+	}
+	return ranges, nil
+}
+
+// multirangeLiteral returns the text format of a multirange.
+func multirangeLiteral(ranges []driver.Valuer) (driver.Value, error) {
+	var b strings.Builder
+	b.WriteByte('{')
+	for i, r := range ranges {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		v, err := r.Value()
+		if err != nil {
+			return nil, err
+		}
+		b.WriteString(v.(string))
+	}
+	b.WriteByte('}')
+	return b.String(), nil
+}
+{{end}}
+
+{{if or .Composites .Ranges}}
+// splitTextFields splits the fields of a composite value or the bounds of a
+// range, which are separated by commas and may be quoted. A nil field is NULL.
+func splitTextFields(s string) []*string {
+	var fields []*string
+	var b strings.Builder
+	quoted, present := false, false
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '\\' && i+1 < len(s):
+			i++
+			b.WriteByte(s[i])
+			present = true
+		case c == '"' && quoted && i+1 < len(s) && s[i+1] == '"':
+			i++
+			b.WriteByte('"')
+		case c == '"':
+			quoted = !quoted
+			present = true
+		case c == ',' && !quoted:
+			fields = append(fields, textField(&b, present))
+			present = false
+		default:
+			b.WriteByte(c)
+			present = true
+		}
+	}
+	return append(fields, textField(&b, present))
+}
+
+func textField(b *strings.Builder, present bool) *string {
+	if !present {
+		return nil
+	}
+	s := b.String()
+	b.Reset()
+	return &s
+}
+
+// scanTextField converts the text of a field into dst. NULL fields leave dst
+// unchanged.
+func scanTextField(dst interface{}, src *string) error {
+	if src == nil {
+		return nil
+	}
+	switch d := dst.(type) {
+	case *json.RawMessage:
+		*d = json.RawMessage(*src)
+		return nil
+	case *time.Time:
+		t, err := parseTextTime(*src)
+		*d = t
+		return err
+	case *sql.NullTime:
+		t, err := parseTextTime(*src)
+		*d = sql.NullTime{Time: t, Valid: err == nil}
+		return err
+	case sql.Scanner:
+		return d.Scan(*src)
+	}
+	v := reflect.ValueOf(dst).Elem()
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(*src)
+	case reflect.Slice:
+		if v.Type().Elem().Kind() != reflect.Uint8 {
+			return fmt.Errorf("unsupported field type %T", dst)
+		}
+		b, err := hex.DecodeString(strings.TrimPrefix(*src, "\\x"))
+		if err != nil {
+			return err
+		}
+		v.SetBytes(b)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(*src)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(*src, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(*src, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	case reflect.Interface:
+		v.Set(reflect.ValueOf(*src))
+	default:
+		return fmt.Errorf("unsupported field type %T", dst)
+	}
+	return nil
+}
+
+func parseTextTime(s string) (time.Time, error) {
+	for _, layout := range []string{
+		"2006-01-02 15:04:05.999999999Z07",
+		"2006-01-02 15:04:05.999999999Z07:00",
+		"2006-01-02 15:04:05.999999999",
+		"2006-01-02",
+		"15:04:05.999999999",
+	} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time: %q", s)
+}
+
+// writeTextField writes a quoted field of a composite value or a bound of a
+// range. NULL is written as nothing.
+func writeTextField(b *strings.Builder, field interface{}) error {
+	if v, ok := field.(driver.Valuer); ok {
+		val, err := v.Value()
+		if err != nil {
+			return err
+		}
+		field = val
+	}
+	var s string
+	switch f := field.(type) {
+	case nil:
+		return nil
+	case string:
+		s = f
+	case json.RawMessage:
+		if f == nil {
+			return nil
+		}
+		s = string(f)
+	case []byte:
+		s = "\\x" + hex.EncodeToString(f)
+	case time.Time:
+		s = f.Format("2006-01-02 15:04:05.999999999Z07:00")
+	default:
+		v := reflect.ValueOf(field)
+		switch {
+		case v.Kind() == reflect.String:
+			s = v.String()
+		case v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8:
+			s = "\\x" + hex.EncodeToString(v.Bytes())
+		default:
+			s = fmt.Sprint(field)
+		}
+	}
+	b.WriteByte('"')
+	b.WriteString(strings.NewReplacer("\\", "\\\\", "\"", "\\\"").Replace(s))
+	b.WriteByte('"')
+	return nil
+}
 {{end}}
 
 {{range .Structs}}
@@ -334,6 +985,22 @@ func (q *Queries) {{.MethodName}}(ctx context.Context, {{.Arg.Pair}}) (int64, er
 }
 {{end}}
 
+{{if eq .Cmd ":execlastid"}}
+{{range .Comments}}//{{.}}
+{{end -}}
+func (q *Queries) {{.MethodName}}(ctx context.Context, {{.Arg.Pair}}) (int64, error) {
+  	{{- if $.EmitPreparedQueries}}
+	result, err := q.exec(ctx, q.{{.FieldName}}, {{.ConstantName}}, {{.Arg.Params}})
+  	{{- else}}
+	result, err := q.db.ExecContext(ctx, {{.ConstantName}}, {{.Arg.Params}})
+  	{{- end}}
+	if err != nil {
+		return 0, err
+	}
+	return result.LastInsertId()
+}
+{{end}}
+
 {{if eq .Cmd ":execresult"}}
 {{range .Comments}}//{{.}}
 {{end -}}
@@ -351,10 +1018,22 @@ func (q *Queries) {{.MethodName}}(ctx context.Context, {{.Arg.Pair}}) (sql.Resul
 `
 
 type tmplCtx struct {
-	Q         string
-	Package   string
-	Enums     []Enum
-	Structs   []Struct
+	Q           string
+	Package     string
+	Enums       []Enum
+	Composites  []Composite
+	Ranges      []Range
+	Multiranges []Multirange
+	Structs     []Struct
+
+	// The names of the Interval and NullInterval types, set when a column is
+	// an interval
+	Interval     string
+	NullInterval string
+
+	// NullUint64 is generated when a nullable column is a BIGINT UNSIGNED
+	NullUint64 bool
+
 	GoQueries []Query
 	Settings  config.Config
 
@@ -374,17 +1053,183 @@ func (t *tmplCtx) OutputQuery(sourceName string) bool {
 
 func Generate(r *compiler.Result, settings config.CombinedSettings) (map[string]string, error) {
 	enums := buildEnums(r, settings)
+	composites := buildComposites(r, settings)
+	ranges, multiranges := buildRanges(r, settings)
 	structs := buildStructs(r, settings)
 	queries := buildQueries(r, settings, structs)
-	return generate(settings, enums, structs, queries)
+	used := usedTypes(composites, structs, queries)
+	ranges, multiranges = usedRanges(ranges, multiranges, used)
+	usedNullSets(enums, used)
+	names := renameHelperTypes(enums, composites, ranges, multiranges, structs, queries, used)
+	return generate(settings, enums, composites, ranges, multiranges, structs, queries, used, names)
+}
+
+// usedTypes returns the Go types of the fields of structs and queries.
+func usedTypes(composites []Composite, structs []Struct, queries []Query) map[string]bool {
+	used := map[string]bool{}
+	use := func(typ string) {
+		used[strings.TrimPrefix(typ, "[]")] = true
+	}
+	useStruct := func(s *Struct) {
+		for _, f := range s.Fields {
+			use(f.Type)
+		}
+	}
+	for _, c := range composites {
+		for _, f := range c.Fields {
+			use(f.Type)
+		}
+	}
+	for i := range structs {
+		useStruct(&structs[i])
+	}
+	for _, q := range queries {
+		for _, v := range []QueryValue{q.Arg, q.Ret} {
+			if v.Struct != nil {
+				useStruct(v.Struct)
+			}
+			use(v.Typ)
+		}
+	}
+	return used
 }
 
-func generate(settings config.CombinedSettings, enums []Enum, structs []Struct, queries []Query) (map[string]string, error) {
+// renameHelperTypes renames the interval and range types that clash with a
+// type generated from the schema or the queries, such as the struct of a
+// table named intervals, by prefixing them with Pg. The types of the fields
+// and queries referring to them are renamed too. It returns the new names.
+func renameHelperTypes(enums []Enum, composites []Composite, ranges []Range, multiranges []Multirange, structs []Struct, queries []Query, used map[string]bool) map[string]string {
+	taken := map[string]bool{}
+	for _, e := range enums {
+		taken[e.Name] = true
+		if e.SetName != "" {
+			taken[e.SetName] = true
+		}
+		if e.NullSetName != "" {
+			taken[e.NullSetName] = true
+		}
+	}
+	for _, c := range composites {
+		taken[c.Name] = true
+	}
+	for _, s := range structs {
+		taken[s.Name] = true
+	}
+	for _, q := range queries {
+		for _, v := range []QueryValue{q.Arg, q.Ret} {
+			if v.EmitStruct() {
+				taken[v.Struct.Name] = true
+			}
+		}
+	}
+
+	var helpers []string
+	if used["Interval"] || used["NullInterval"] {
+		helpers = append(helpers, "Interval")
+	}
+	if used["NullInterval"] {
+		helpers = append(helpers, "NullInterval")
+	}
+	for _, r := range ranges {
+		helpers = append(helpers, r.Name)
+		if r.NullName != "" {
+			helpers = append(helpers, r.NullName)
+		}
+	}
+	for _, m := range multiranges {
+		helpers = append(helpers, m.Name)
+	}
+	isHelper := map[string]bool{}
+	for _, name := range helpers {
+		isHelper[name] = true
+	}
+
+	names := map[string]string{}
+	for _, name := range helpers {
+		if !taken[name] {
+			continue
+		}
+		renamed := name
+		for taken[renamed] || isHelper[renamed] {
+			renamed = "Pg" + renamed
+		}
+		taken[renamed] = true
+		names[name] = renamed
+	}
+	if len(names) == 0 {
+		return names
+	}
+
+	var rename func(typ string) string
+	rename = func(typ string) string {
+		if strings.HasPrefix(typ, "[]") {
+			return "[]" + rename(typ[2:])
+		}
+		if name, ok := names[typ]; ok {
+			return name
+		}
+		return typ
+	}
+	renameFields := func(s *Struct) {
+		for i := range s.Fields {
+			s.Fields[i].Type = rename(s.Fields[i].Type)
+		}
+	}
+	for i := range composites {
+		for j := range composites[i].Fields {
+			composites[i].Fields[j].Type = rename(composites[i].Fields[j].Type)
+		}
+	}
+	for i := range ranges {
+		ranges[i].Name = rename(ranges[i].Name)
+		ranges[i].NullName = rename(ranges[i].NullName)
+	}
+	for i := range multiranges {
+		multiranges[i].Name = rename(multiranges[i].Name)
+		multiranges[i].Range = rename(multiranges[i].Range)
+	}
+	for i := range structs {
+		renameFields(&structs[i])
+	}
+	for i := range queries {
+		for _, v := range []*QueryValue{&queries[i].Arg, &queries[i].Ret} {
+			// The type of a struct is the struct's own name
+			if v.Struct != nil {
+				renameFields(v.Struct)
+			} else {
+				v.Typ = rename(v.Typ)
+			}
+		}
+	}
+	return names
+}
+
+// typeName returns the name of a generated type, which may have been renamed.
+func typeName(names map[string]string, name string) string {
+	if renamed, ok := names[name]; ok {
+		return renamed
+	}
+	return name
+}
+
+func generate(settings config.CombinedSettings, enums []Enum, composites []Composite, ranges []Range, multiranges []Multirange, structs []Struct, queries []Query, used map[string]bool, names map[string]string) (map[string]string, error) {
+	var interval, nullInterval string
+	if used["Interval"] || used["NullInterval"] {
+		interval = typeName(names, "Interval")
+	}
+	if used["NullInterval"] {
+		nullInterval = typeName(names, "NullInterval")
+	}
+
 	i := &importer{
-		Settings: settings,
-		Queries:  queries,
-		Enums:    enums,
-		Structs:  structs,
+		Settings:   settings,
+		Queries:    queries,
+		Enums:      enums,
+		Composites: composites,
+		Ranges:     ranges,
+		Interval:   interval != "",
+		NullUint64: used["NullUint64"],
+		Structs:    structs,
 	}
 
 	funcMap := template.FuncMap{
@@ -408,7 +1253,13 @@ func generate(settings config.CombinedSettings, enums []Enum, structs []Struct,
 		Package:             golang.Package,
 		GoQueries:           queries,
 		Enums:               enums,
+		Composites:          composites,
+		Ranges:              ranges,
+		Multiranges:         multiranges,
 		Structs:             structs,
+		Interval:            interval,
+		NullInterval:        nullInterval,
+		NullUint64:          used["NullUint64"],
 	}
 
 	output := map[string]string{}
@@ -26,14 +26,14 @@ func goInnerType(r *compiler.Result, col *compiler.Column, settings config.Combi
 
 	// package overrides have a higher precedence
 	for _, oride := range settings.Overrides {
-		if oride.DBType != "" && oride.DBType == columnType && oride.Nullable != notNull {
+		if oride.DBType != "" && oride.DBType == columnType && oride.Nullable != notNull && oride.Unsigned == col.Unsigned {
 			return oride.GoTypeName
 		}
 	}
 
 	// TODO: Extend the engine interface to handle types
 	switch settings.Package.Engine {
-	case config.EngineMySQL:
+	case config.EngineMySQL, config.EngineMariaDB:
 		return mysqlType(r, col, settings)
 	case config.EnginePostgreSQL:
 		return postgresType(r, col, settings)
@@ -58,10 +58,14 @@ func mergeImports(imps ...fileImports) [][]ImportSpec {
 }
 
 type importer struct {
-	Settings config.CombinedSettings
-	Queries  []Query
-	Enums    []Enum
-	Structs  []Struct
+	Settings   config.CombinedSettings
+	Queries    []Query
+	Enums      []Enum
+	Composites []Composite
+	Ranges     []Range
+	Interval   bool
+	NullUint64 bool
+	Structs    []Struct
 }
 
 func (i *importer) usesType(typ string) bool {
@@ -73,6 +77,14 @@ func (i *importer) usesType(typ string) bool {
 			}
 		}
 	}
+	for _, c := range i.Composites {
+		for _, f := range c.Fields {
+			fType := strings.TrimPrefix(f.Type, "[]")
+			if strings.HasPrefix(fType, typ) {
+				return true
+			}
+		}
+	}
 	return false
 }
 
@@ -217,9 +229,40 @@ func (i *importer) modelImports() fileImports {
 	if len(i.Enums) > 0 {
 		std["fmt"] = struct{}{}
 	}
+	for _, e := range i.Enums {
+		if e.SetName != "" {
+			std["database/sql/driver"] = struct{}{}
+			std["strings"] = struct{}{}
+		}
+	}
+	if len(i.Composites) > 0 || len(i.Ranges) > 0 {
+		for _, path := range []string{"database/sql", "database/sql/driver", "encoding/hex", "encoding/json", "fmt", "reflect", "strconv", "strings", "time"} {
+			std[path] = struct{}{}
+		}
+	}
+	if i.NullUint64 {
+		for _, path := range []string{"database/sql/driver", "fmt", "strconv"} {
+			std[path] = struct{}{}
+		}
+	}
+	if i.Interval {
+		for _, path := range []string{"database/sql/driver", "fmt", "time"} {
+			std[path] = struct{}{}
+		}
+	}
 
 	// Custom imports
 	pkg := make(map[ImportSpec]struct{})
+	if i.Interval {
+		pkg[ImportSpec{Path: "github.com/kyleconroy/sqlc/pkg/pginterval"}] = struct{}{}
+	}
+	for _, c := range i.Composites {
+		for _, f := range c.Fields {
+			if f.IsArray {
+				pkg[ImportSpec{Path: "github.com/lib/pq"}] = struct{}{}
+			}
+		}
+	}
 	overrideTypes := map[string]string{}
 	for _, o := range i.Settings.Overrides {
 		if o.GoBasicType {
@@ -21,21 +21,83 @@ func mysqlType(r *compiler.Result, col *compiler.Column, settings config.Combine
 		}
 		return "sql.NullString"
 
-	case "int", "integer", "tinyint", "smallint", "mediumint", "year":
+	case "uuid", "inet4", "inet6":
+		// MariaDB sends these in their text form
+		if notNull {
+			return "string"
+		}
+		return "sql.NullString"
+
+	case "tinyint":
+		if col.Length != nil && *col.Length == 1 && !col.Unsigned {
+			// BOOL and BOOLEAN are synonyms for TINYINT(1)
+			if notNull {
+				return "bool"
+			}
+			return "sql.NullBool"
+		}
+		if col.Unsigned {
+			if notNull {
+				return "uint8"
+			}
+			return "sql.NullInt32"
+		}
+		if notNull {
+			return "int32"
+		}
+		return "sql.NullInt32"
+
+	case "smallint":
+		if col.Unsigned {
+			if notNull {
+				return "uint16"
+			}
+			return "sql.NullInt32"
+		}
+		if notNull {
+			return "int32"
+		}
+		return "sql.NullInt32"
+
+	case "int", "integer", "mediumint":
+		if col.Unsigned {
+			if notNull {
+				return "uint32"
+			}
+			return "sql.NullInt64"
+		}
+		if notNull {
+			return "int32"
+		}
+		return "sql.NullInt32"
+
+	case "year":
 		if notNull {
 			return "int32"
 		}
 		return "sql.NullInt32"
 
 	case "bigint":
+		if col.Unsigned {
+			if notNull {
+				return "uint64"
+			}
+			return "NullUint64"
+		}
 		if notNull {
 			return "int64"
 		}
 		return "sql.NullInt64"
 
-	case "blob", "binary", "varbinary", "tinyblob", "mediumblob", "longblob":
+	case "blob", "binary", "varbinary", "tinyblob", "mediumblob", "longblob", "bit":
 		return "[]byte"
 
+	case "float":
+		if notNull {
+			return "float32"
+		}
+		return "sql.NullFloat64"
+
 	case "double", "double precision", "real":
 		if notNull {
 			return "float64"
@@ -48,9 +110,13 @@ func mysqlType(r *compiler.Result, col *compiler.Column, settings config.Combine
 		}
 		return "sql.NullString"
 
-	case "enum":
-		// TODO: Proper Enum support
-		return "string"
+	case "enum", "set":
+		// Columns declared with their values have their own type, so only
+		// casts and the like end up here
+		if notNull {
+			return "string"
+		}
+		return "sql.NullString"
 
 	case "date", "timestamp", "datetime", "time":
 		if notNull {
@@ -71,16 +137,31 @@ func mysqlType(r *compiler.Result, col *compiler.Column, settings config.Combine
 		return "interface{}"
 
 	default:
+		rel, err := compiler.ParseRelationString(columnType)
+		if err != nil {
+			return "interface{}"
+		}
 		for _, schema := range r.Catalog.Schemas {
 			for _, typ := range schema.Types {
 				switch t := typ.(type) {
 				case *catalog.Enum:
-					if t.Name == columnType {
+					if t.Name == rel.Name && schema.Name == rel.Schema {
 						if schema.Name == r.Catalog.DefaultSchema {
 							return StructName(t.Name, settings)
 						}
 						return StructName(schema.Name+"_"+t.Name, settings)
 					}
+				case *catalog.Set:
+					if t.Name == rel.Name && schema.Name == rel.Schema {
+						name := StructName(t.Name, settings) + "Set"
+						if schema.Name != r.Catalog.DefaultSchema {
+							name = StructName(schema.Name+"_"+t.Name, settings) + "Set"
+						}
+						if notNull {
+							return name
+						}
+						return "Null" + name
+					}
 				}
 			}
 		}
@@ -84,19 +84,19 @@ func postgresType(r *compiler.Result, col *compiler.Column, settings config.Comb
 		}
 		return "sql.NullTime"
 
-	case "pg_catalog.time", "pg_catalog.timetz":
+	case "pg_catalog.time", "pg_catalog.timetz", "time without time zone", "time with time zone":
 		if notNull {
 			return "time.Time"
 		}
 		return "sql.NullTime"
 
-	case "pg_catalog.timestamp", "pg_catalog.timestamptz", "timestamptz":
+	case "pg_catalog.timestamp", "pg_catalog.timestamptz", "timestamptz", "timestamp without time zone", "timestamp with time zone":
 		if notNull {
 			return "time.Time"
 		}
 		return "sql.NullTime"
 
-	case "text", "pg_catalog.varchar", "pg_catalog.bpchar", "string":
+	case "text", "pg_catalog.varchar", "pg_catalog.bpchar", "string", "character varying", "character", "citext":
 		if notNull {
 			return "string"
 		}
@@ -124,9 +124,9 @@ func postgresType(r *compiler.Result, col *compiler.Column, settings config.Comb
 
 	case "interval", "pg_catalog.interval":
 		if notNull {
-			return "int64"
+			return "Interval"
 		}
-		return "sql.NullInt64"
+		return "NullInterval"
 
 	case "void":
 		// A void value can only be scanned into an empty interface.
@@ -141,6 +141,9 @@ func postgresType(r *compiler.Result, col *compiler.Column, settings config.Comb
 			// TODO: Should this actually return an error here?
 			return "interface{}"
 		}
+		if typ, ok := postgresRangeType(r, rel, notNull, settings); ok {
+			return typ
+		}
 		if rel.Schema == "" {
 			rel.Schema = r.Catalog.DefaultSchema
 		}
@@ -158,11 +161,17 @@ func postgresType(r *compiler.Result, col *compiler.Column, settings config.Comb
 						}
 						return StructName(schema.Name+"_"+t.Name, settings)
 					}
+				case *catalog.Domain:
+					if rel.Name == t.Name && rel.Schema == schema.Name {
+						return postgresDomainType(r, col, t, settings)
+					}
 				case *catalog.CompositeType:
-					if notNull {
-						return "string"
+					if rel.Name == t.Name && rel.Schema == schema.Name {
+						if schema.Name == r.Catalog.DefaultSchema {
+							return StructName(t.Name, settings)
+						}
+						return StructName(schema.Name+"_"+t.Name, settings)
 					}
-					return "sql.NullString"
 				}
 			}
 		}
@@ -172,3 +181,19 @@ func postgresType(r *compiler.Result, col *compiler.Column, settings config.Comb
 		return "interface{}"
 	}
 }
+
+// postgresDomainType returns the Go type of a column of a domain, which is
+// stored as a value of the domain's base type.
+func postgresDomainType(r *compiler.Result, col *compiler.Column, d *catalog.Domain, settings config.CombinedSettings) string {
+	base := *col
+	base.DataType = d.BaseType.Name
+	if d.BaseType.Schema != "" {
+		base.DataType = d.BaseType.Schema + "." + d.BaseType.Name
+	}
+	base.NotNull = col.NotNull || d.NotNull
+	if d.IsArray {
+		base.IsArray = true
+		return "[]" + postgresType(r, &base, settings)
+	}
+	return postgresType(r, &base, settings)
+}
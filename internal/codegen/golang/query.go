@@ -11,6 +11,10 @@ type QueryValue struct {
 	Name   string
 	Struct *Struct
 	Typ    string
+
+	// The index of the field passed for each placeholder, when a field is
+	// passed more than once
+	Bindings []int
 }
 
 func (v QueryValue) EmitStruct() bool {
@@ -62,6 +66,13 @@ func (v QueryValue) Params() string {
 			}
 		}
 	}
+	if v.Bindings != nil {
+		values := out
+		out = nil
+		for _, i := range v.Bindings {
+			out = append(out, values[i])
+		}
+	}
 	if len(out) <= 3 {
 		return strings.Join(out, ",")
 	}
@@ -0,0 +1,142 @@
+package golang
+
+import (
+	"sort"
+
+	"github.com/kyleconroy/sqlc/internal/compiler"
+	"github.com/kyleconroy/sqlc/internal/config"
+	"github.com/kyleconroy/sqlc/internal/sql/catalog"
+)
+
+// A Range is a Go struct for a PostgreSQL range type. It's scanned from and
+// converted to the text format of a range, such as `[2020-01-01,2021-01-01)`.
+// An unbounded bound is NULL.
+//
+// https://www.postgresql.org/docs/current/rangetypes.html#RANGETYPES-IO
+type Range struct {
+	Name      string
+	Comment   string
+	BoundType string
+
+	// NullName is the name of the struct for a range that may be NULL, set
+	// when a nullable column is of the range type
+	NullName string
+
+	// Infinity is set when the bounds are times, which may be infinity or
+	// -infinity
+	Infinity bool
+}
+
+// A Multirange is a slice of the Go struct of its range type.
+type Multirange struct {
+	Name    string
+	Comment string
+	Range   string
+}
+
+// rangeTypeName returns the Go name of a range or multirange type. The
+// built-in types of pg_catalog are named like those of the default schema.
+func rangeTypeName(r *compiler.Result, schema, name string, settings config.CombinedSettings) string {
+	if schema == "pg_catalog" || schema == r.Catalog.DefaultSchema {
+		return StructName(name, settings)
+	}
+	return StructName(schema+"_"+name, settings)
+}
+
+// postgresRangeType returns the Go type of a range or multirange type, if
+// the type is one. A range that may be NULL has a type of its own, as the
+// zero value of a range is an unbounded range; a multirange is a slice, which
+// is nil when NULL.
+func postgresRangeType(r *compiler.Result, rel *compiler.Relation, notNull bool, settings config.CombinedSettings) (string, bool) {
+	schemas := []string{rel.Schema}
+	if rel.Schema == "" {
+		schemas = []string{"pg_catalog", r.Catalog.DefaultSchema}
+	}
+	for _, schema := range r.Catalog.Schemas {
+		if !contains(schemas, schema.Name) {
+			continue
+		}
+		for _, typ := range schema.Types {
+			switch t := typ.(type) {
+			case *catalog.Range:
+				if t.Name == rel.Name {
+					name := rangeTypeName(r, schema.Name, t.Name, settings)
+					if !notNull {
+						return "Null" + name, true
+					}
+					return name, true
+				}
+			case *catalog.Multirange:
+				if t.Name == rel.Name {
+					return rangeTypeName(r, schema.Name, t.Name, settings), true
+				}
+			}
+		}
+	}
+	return "", false
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func buildRanges(r *compiler.Result, settings config.CombinedSettings) ([]Range, []Multirange) {
+	var ranges []Range
+	var multiranges []Multirange
+	for _, schema := range r.Catalog.Schemas {
+		for _, typ := range schema.Types {
+			switch t := typ.(type) {
+			case *catalog.Range:
+				subtype := t.Subtype.Name
+				if t.Subtype.Schema != "" {
+					subtype = t.Subtype.Schema + "." + subtype
+				}
+				bound := goType(r, &compiler.Column{DataType: subtype}, settings)
+				ranges = append(ranges, Range{
+					Name:      rangeTypeName(r, schema.Name, t.Name, settings),
+					Comment:   t.Comment,
+					BoundType: bound,
+					Infinity:  bound == "sql.NullTime" || bound == "time.Time",
+				})
+			case *catalog.Multirange:
+				multiranges = append(multiranges, Multirange{
+					Name:    rangeTypeName(r, schema.Name, t.Name, settings),
+					Comment: t.Comment,
+					Range:   rangeTypeName(r, schema.Name, t.Range, settings),
+				})
+			}
+		}
+	}
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i].Name < ranges[j].Name })
+	sort.Slice(multiranges, func(i, j int) bool { return multiranges[i].Name < multiranges[j].Name })
+	return ranges, multiranges
+}
+
+// usedRanges drops the range and multirange types no struct or query refers
+// to, so that the types of pg_catalog are only generated when needed.
+func usedRanges(ranges []Range, multiranges []Multirange, used map[string]bool) ([]Range, []Multirange) {
+	var keptMultiranges []Multirange
+	for _, m := range multiranges {
+		if used[m.Name] {
+			keptMultiranges = append(keptMultiranges, m)
+			used[m.Range] = true
+		}
+	}
+	var keptRanges []Range
+	for _, rng := range ranges {
+		if used["Null"+rng.Name] {
+			rng.NullName = "Null" + rng.Name
+			used[rng.Name] = true
+		}
+		if used[rng.Name] {
+			keptRanges = append(keptRanges, rng)
+			used[rng.BoundType] = true
+		}
+	}
+	return keptRanges, keptMultiranges
+}
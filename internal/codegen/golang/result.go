@@ -10,6 +10,7 @@ import (
 	"github.com/kyleconroy/sqlc/internal/config"
 	"github.com/kyleconroy/sqlc/internal/core"
 	"github.com/kyleconroy/sqlc/internal/inflection"
+	"github.com/kyleconroy/sqlc/internal/sql/ast"
 	"github.com/kyleconroy/sqlc/internal/sql/catalog"
 )
 
@@ -20,21 +21,31 @@ func buildEnums(r *compiler.Result, settings config.CombinedSettings) []Enum {
 			continue
 		}
 		for _, typ := range schema.Types {
-			enum, ok := typ.(*catalog.Enum)
-			if !ok {
+			var name, comment string
+			var vals []string
+			var isSet bool
+			switch t := typ.(type) {
+			case *catalog.Enum:
+				name, comment, vals = t.Name, t.Comment, t.Vals
+			case *catalog.Set:
+				name, comment, vals, isSet = t.Name, t.Comment, t.Vals, true
+			default:
 				continue
 			}
 			var enumName string
 			if schema.Name == r.Catalog.DefaultSchema {
-				enumName = enum.Name
+				enumName = name
 			} else {
-				enumName = schema.Name + "_" + enum.Name
+				enumName = schema.Name + "_" + name
 			}
 			e := Enum{
 				Name:    StructName(enumName, settings),
-				Comment: enum.Comment,
+				Comment: comment,
+			}
+			if isSet {
+				e.SetName = e.Name + "Set"
 			}
-			for _, v := range enum.Vals {
+			for _, v := range vals {
 				e.Constants = append(e.Constants, Constant{
 					Name:  StructName(enumName+"_"+EnumReplace(v), settings),
 					Value: v,
@@ -50,6 +61,55 @@ func buildEnums(r *compiler.Result, settings config.CombinedSettings) []Enum {
 	return enums
 }
 
+func buildComposites(r *compiler.Result, settings config.CombinedSettings) []Composite {
+	var composites []Composite
+	for _, schema := range r.Catalog.Schemas {
+		if schema.Name == "pg_catalog" {
+			continue
+		}
+		for _, typ := range schema.Types {
+			ct, ok := typ.(*catalog.CompositeType)
+			if !ok {
+				continue
+			}
+			var typeName string
+			if schema.Name == r.Catalog.DefaultSchema {
+				typeName = ct.Name
+			} else {
+				typeName = schema.Name + "_" + ct.Name
+			}
+			c := Composite{
+				Name:    StructName(typeName, settings),
+				Comment: ct.Comment,
+			}
+			rel := &ast.TableName{Schema: schema.Name, Name: ct.Name}
+			for _, column := range ct.Columns {
+				tags := map[string]string{}
+				if settings.Go.EmitDBTags {
+					tags["db:"] = column.Name
+				}
+				if settings.Go.EmitJSONTags {
+					tags["json:"] = column.Name
+				}
+				c.Fields = append(c.Fields, CompositeField{
+					Field: Field{
+						Name:    StructName(column.Name, settings),
+						Type:    goType(r, compiler.ConvertColumn(rel, column), settings),
+						Tags:    tags,
+						Comment: column.Comment,
+					},
+					IsArray: column.IsArray,
+				})
+			}
+			composites = append(composites, c)
+		}
+	}
+	if len(composites) > 0 {
+		sort.Slice(composites, func(i, j int) bool { return composites[i].Name < composites[j].Name })
+	}
+	return composites
+}
+
 func buildStructs(r *compiler.Result, settings config.CombinedSettings) []Struct {
 	var structs []Struct
 	for _, schema := range r.Catalog.Schemas {
@@ -108,6 +168,32 @@ func columnName(c *compiler.Column, pos int) string {
 	return fmt.Sprintf("column_%d", pos+1)
 }
 
+// uniqueParams returns the parameters of a query without repeats. A query
+// with positional placeholders lists a parameter once for each placeholder
+// it's passed to; in that case the index of the parameter passed to each
+// placeholder is returned too.
+func uniqueParams(in []compiler.Parameter) ([]compiler.Parameter, []int) {
+	var out []compiler.Parameter
+	var bindings []int
+	var repeated bool
+	index := map[int]int{}
+	for _, p := range in {
+		i, ok := index[p.Number]
+		if ok {
+			repeated = true
+		} else {
+			i = len(out)
+			index[p.Number] = i
+			out = append(out, p)
+		}
+		bindings = append(bindings, i)
+	}
+	if !repeated {
+		return out, nil
+	}
+	return out, bindings
+}
+
 func paramName(p compiler.Parameter) string {
 	if p.Column.Name != "" {
 		return argName(p.Column.Name)
@@ -149,24 +235,27 @@ func buildQueries(r *compiler.Result, settings config.CombinedSettings, structs
 			Comments:     query.Comments,
 		}
 
-		if len(query.Params) == 1 {
-			p := query.Params[0]
+		params, bindings := uniqueParams(query.Params)
+		if len(params) == 1 {
+			p := params[0]
 			gq.Arg = QueryValue{
-				Name: paramName(p),
-				Typ:  goType(r, p.Column, settings),
+				Name:     paramName(p),
+				Typ:      goType(r, p.Column, settings),
+				Bindings: bindings,
 			}
-		} else if len(query.Params) > 1 {
+		} else if len(params) > 1 {
 			var cols []goColumn
-			for _, p := range query.Params {
+			for _, p := range params {
 				cols = append(cols, goColumn{
 					id:     p.Number,
 					Column: p.Column,
 				})
 			}
 			gq.Arg = QueryValue{
-				Emit:   true,
-				Name:   "arg",
-				Struct: columnsToStruct(r, gq.MethodName+"Params", cols, settings),
+				Emit:     true,
+				Name:     "arg",
+				Struct:   columnsToStruct(r, gq.MethodName+"Params", cols, settings),
+				Bindings: bindings,
 			}
 		}
 
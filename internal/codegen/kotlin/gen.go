@@ -18,13 +18,13 @@ import (
 	"github.com/kyleconroy/sqlc/internal/sql/catalog"
 )
 
-func sameTableName(n *ast.TableName, f core.FQN) bool {
+func sameTableName(n *ast.TableName, f core.FQN, defaultSchema string) bool {
 	if n == nil {
 		return false
 	}
 	schema := n.Schema
 	if n.Schema == "" {
-		schema = "public"
+		schema = defaultSchema
 	}
 	return n.Catalog == n.Catalog && schema == f.Schema && n.Name == f.Rel
 }
@@ -88,20 +88,30 @@ func (v QueryValue) Type() string {
 }
 
 func jdbcSet(t ktType, idx int, name string) string {
+	if t.IsSet {
+		if t.IsNull {
+			return fmt.Sprintf(`stmt.setString(%d, %s?.joinToString(",") { v -> v.value })`, idx, name)
+		}
+		return fmt.Sprintf(`stmt.setString(%d, %s.joinToString(",") { v -> v.value })`, idx, name)
+	}
 	if t.IsEnum && t.IsArray {
 		return fmt.Sprintf(`stmt.setArray(%d, conn.createArrayOf("%s", %s.map { v -> v.value }.toTypedArray()))`, idx, t.DataType, name)
 	}
 	if t.IsEnum {
+		value := name + ".value"
+		if t.IsNull {
+			value = name + "?.value"
+		}
 		if t.Engine == config.EnginePostgreSQL {
-			return fmt.Sprintf("stmt.setObject(%d, %s.value, %s)", idx, name, "Types.OTHER")
+			return fmt.Sprintf("stmt.setObject(%d, %s, %s)", idx, value, "Types.OTHER")
 		} else {
-			return fmt.Sprintf("stmt.setString(%d, %s.value)", idx, name)
+			return fmt.Sprintf("stmt.setString(%d, %s)", idx, value)
 		}
 	}
 	if t.IsArray {
 		return fmt.Sprintf(`stmt.setArray(%d, conn.createArrayOf("%s", %s.toTypedArray()))`, idx, t.DataType, name)
 	}
-	if t.IsTime() {
+	if t.IsTime() || t.IsInterval() {
 		return fmt.Sprintf("stmt.setObject(%d, %s)", idx, name)
 	}
 	if t.IsInstant() {
@@ -144,16 +154,25 @@ func (v Params) Bindings() string {
 }
 
 func jdbcGet(t ktType, idx int) string {
+	if t.IsSet {
+		if t.IsNull {
+			return fmt.Sprintf(`results.getString(%d)?.split(",")?.filter { v -> v != "" }?.map { v -> %s.lookup(v)!! }?.toSet()`, idx, t.Name)
+		}
+		return fmt.Sprintf(`results.getString(%d).split(",").filter { v -> v != "" }.map { v -> %s.lookup(v)!! }.toSet()`, idx, t.Name)
+	}
 	if t.IsEnum && t.IsArray {
 		return fmt.Sprintf(`(results.getArray(%d).array as Array<String>).map { v -> %s.lookup(v)!! }.toList()`, idx, t.Name)
 	}
+	if t.IsEnum && t.IsNull {
+		return fmt.Sprintf("results.getString(%d)?.let { v -> %s.lookup(v)!! }", idx, t.Name)
+	}
 	if t.IsEnum {
 		return fmt.Sprintf("%s.lookup(results.getString(%d))!!", t.Name, idx)
 	}
 	if t.IsArray {
 		return fmt.Sprintf(`(results.getArray(%d).array as Array<%s>).toList()`, idx, t.Name)
 	}
-	if t.IsTime() {
+	if t.IsTime() || t.IsInterval() {
 		return fmt.Sprintf(`results.getObject(%d, %s::class.java)`, idx, t.Name)
 	}
 	if t.IsInstant() {
@@ -223,21 +242,27 @@ func buildEnums(r *compiler.Result, settings config.CombinedSettings) []Enum {
 			continue
 		}
 		for _, typ := range schema.Types {
-			enum, ok := typ.(*catalog.Enum)
-			if !ok {
+			var name, comment string
+			var vals []string
+			switch t := typ.(type) {
+			case *catalog.Enum:
+				name, comment, vals = t.Name, t.Comment, t.Vals
+			case *catalog.Set:
+				name, comment, vals = t.Name, t.Comment, t.Vals
+			default:
 				continue
 			}
 			var enumName string
 			if schema.Name == r.Catalog.DefaultSchema {
-				enumName = enum.Name
+				enumName = name
 			} else {
-				enumName = schema.Name + "_" + enum.Name
+				enumName = schema.Name + "_" + name
 			}
 			e := Enum{
 				Name:    DataClassName(enumName, settings),
-				Comment: enum.Comment,
+				Comment: comment,
 			}
-			for _, v := range enum.Vals {
+			for _, v := range vals {
 				e.Constants = append(e.Constants, Constant{
 					Name:  ktEnumValueName(v),
 					Value: v,
@@ -309,6 +334,7 @@ func buildDataClasses(r *compiler.Result, settings config.CombinedSettings) []St
 type ktType struct {
 	Name     string
 	IsEnum   bool
+	IsSet    bool
 	IsArray  bool
 	IsNull   bool
 	DataType string
@@ -319,7 +345,10 @@ func (t ktType) String() string {
 	v := t.Name
 	if t.IsArray {
 		v = fmt.Sprintf("List<%s>", v)
-	} else if t.IsNull {
+	} else if t.IsSet {
+		v = fmt.Sprintf("Set<%s>", v)
+	}
+	if t.IsNull && !t.IsArray {
 		v += "?"
 	}
 	return v
@@ -333,7 +362,7 @@ func (t ktType) jdbcType() string {
 	if t.IsArray {
 		return "Array"
 	}
-	if t.IsEnum || t.IsTime() {
+	if t.IsEnum || t.IsTime() || t.IsInterval() {
 		return "Object"
 	}
 	if t.IsInstant() {
@@ -346,6 +375,10 @@ func (t ktType) IsTime() bool {
 	return t.Name == "LocalDate" || t.Name == "LocalDateTime" || t.Name == "LocalTime" || t.Name == "OffsetDateTime"
 }
 
+func (t ktType) IsInterval() bool {
+	return t.Name == "PGInterval"
+}
+
 func (t ktType) IsInstant() bool {
 	return t.Name == "Instant"
 }
@@ -355,6 +388,7 @@ func makeType(r *compiler.Result, col *compiler.Column, settings config.Combined
 	return ktType{
 		Name:     typ,
 		IsEnum:   isEnum,
+		IsSet:    isEnum && (settings.Package.Engine == config.EngineMySQL || settings.Package.Engine == config.EngineMariaDB) && isSetType(r, col),
 		IsArray:  col.IsArray,
 		IsNull:   !col.NotNull,
 		DataType: col.DataType,
@@ -365,7 +399,7 @@ func makeType(r *compiler.Result, col *compiler.Column, settings config.Combined
 func ktInnerType(r *compiler.Result, col *compiler.Column, settings config.CombinedSettings) (string, bool) {
 	// TODO: Extend the engine interface to handle types
 	switch settings.Package.Engine {
-	case config.EngineMySQL:
+	case config.EngineMySQL, config.EngineMariaDB:
 		return mysqlType(r, col, settings)
 	case config.EnginePostgreSQL:
 		return postgresType(r, col, settings)
@@ -496,7 +530,7 @@ func buildQueries(r *compiler.Result, settings config.CombinedSettings, structs
 					c := query.Columns[i]
 					sameName := f.Name == MemberName(ktColumnName(c, i), settings)
 					sameType := f.Type == makeType(r, c, settings)
-					sameTable := sameTableName(c.Table, s.Table)
+					sameTable := sameTableName(c.Table, s.Table, r.Catalog.DefaultSchema)
 
 					if !sameName || !sameType || !sameTable {
 						same = false
@@ -556,7 +590,7 @@ interface Queries {
   {{- if eq .Cmd ":execrows"}}
   fun {{.MethodName}}({{.Arg.Args}}): Int
   {{- end}}
-  {{- if eq .Cmd ":execresult"}}
+  {{- if or (eq .Cmd ":execresult") (eq .Cmd ":execlastid")}}
   fun {{.MethodName}}({{.Arg.Args}}): Long
   {{- end}}
   {{end}}
@@ -693,7 +727,7 @@ class QueriesImpl(private val conn: Connection) : Queries {
   }
 {{end}}
 
-{{if eq .Cmd ":execresult"}}
+{{if or (eq .Cmd ":execresult") (eq .Cmd ":execlastid")}}
 {{range .Comments}}//{{.}}
 {{end}}
   @Throws(SQLException::class)
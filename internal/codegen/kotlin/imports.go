@@ -83,6 +83,9 @@ func (i *importer) modelImports() [][]string {
 	if i.usesType("OffsetDateTime") {
 		std["java.time.OffsetDateTime"] = struct{}{}
 	}
+	if i.usesType("PGInterval") {
+		std["org.postgresql.util.PGInterval"] = struct{}{}
+	}
 
 	stds := make([]string, 0, len(std))
 	for s, _ := range std {
@@ -114,6 +117,9 @@ func stdImports(uses func(name string) bool) map[string]struct{} {
 	if uses("OffsetDateTime") {
 		std["java.time.OffsetDateTime"] = struct{}{}
 	}
+	if uses("PGInterval") {
+		std["org.postgresql.util.PGInterval"] = struct{}{}
+	}
 	return std
 }
 
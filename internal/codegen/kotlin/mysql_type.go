@@ -17,6 +17,10 @@ func mysqlType(r *compiler.Result, col *compiler.Column, settings config.Combine
 	case "varchar", "text", "char", "tinytext", "mediumtext", "longtext":
 		return "String", false
 
+	case "uuid", "inet4", "inet6":
+		// MariaDB sends these in their text form
+		return "String", false
+
 	case "int", "integer", "smallint", "mediumint", "year":
 		return "Int", false
 
@@ -32,8 +36,9 @@ func mysqlType(r *compiler.Result, col *compiler.Column, settings config.Combine
 	case "decimal", "dec", "fixed":
 		return "String", false
 
-	case "enum":
-		// TODO: Proper Enum support
+	case "enum", "set":
+		// Columns declared with their values have their own type, so only
+		// casts and the like end up here
 		return "String", false
 
 	case "date", "datetime", "time":
@@ -52,11 +57,22 @@ func mysqlType(r *compiler.Result, col *compiler.Column, settings config.Combine
 		return "Any", false
 
 	default:
+		rel, err := compiler.ParseRelationString(columnType)
+		if err != nil {
+			return "Any", false
+		}
 		for _, schema := range r.Catalog.Schemas {
 			for _, typ := range schema.Types {
 				switch t := typ.(type) {
 				case *catalog.Enum:
-					if t.Name == columnType {
+					if t.Name == rel.Name && schema.Name == rel.Schema {
+						if schema.Name == r.Catalog.DefaultSchema {
+							return DataClassName(t.Name, settings), true
+						}
+						return DataClassName(schema.Name+"_"+t.Name, settings), true
+					}
+				case *catalog.Set:
+					if t.Name == rel.Name && schema.Name == rel.Schema {
 						if schema.Name == r.Catalog.DefaultSchema {
 							return DataClassName(t.Name, settings), true
 						}
@@ -72,3 +88,16 @@ func mysqlType(r *compiler.Result, col *compiler.Column, settings config.Combine
 
 	}
 }
+
+// isSetType reports whether a column is a MySQL SET column, whose values are
+// sets of the values of its enum class.
+func isSetType(r *compiler.Result, col *compiler.Column) bool {
+	for _, schema := range r.Catalog.Schemas {
+		for _, typ := range schema.Types {
+			if t, ok := typ.(*catalog.Set); ok && t.Name == col.DataType {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -53,17 +53,20 @@ func postgresType(r *compiler.Result, col *compiler.Column, settings config.Comb
 		// Date and time mappings from https://jdbc.postgresql.org/documentation/head/java8-date-time.html
 		return "LocalDate", false
 
-	case "pg_catalog.time", "pg_catalog.timetz":
+	case "pg_catalog.time", "pg_catalog.timetz", "time without time zone", "time with time zone":
 		return "LocalTime", false
 
-	case "pg_catalog.timestamp":
+	case "pg_catalog.timestamp", "timestamp without time zone":
 		return "LocalDateTime", false
 
-	case "pg_catalog.timestamptz", "timestamptz":
+	case "pg_catalog.timestamptz", "timestamptz", "timestamp with time zone":
 		// TODO
 		return "OffsetDateTime", false
 
-	case "text", "pg_catalog.varchar", "pg_catalog.bpchar", "string":
+	case "interval", "pg_catalog.interval":
+		return "PGInterval", false
+
+	case "text", "pg_catalog.varchar", "pg_catalog.bpchar", "string", "character varying", "character", "citext":
 		return "String", false
 
 	case "uuid":
@@ -90,6 +93,14 @@ func postgresType(r *compiler.Result, col *compiler.Column, settings config.Comb
 				continue
 			}
 			for _, typ := range schema.Types {
+				if d, ok := typ.(*catalog.Domain); ok && columnType == d.Name {
+					base := *col
+					base.DataType = d.BaseType.Name
+					if d.BaseType.Schema != "" {
+						base.DataType = d.BaseType.Schema + "." + d.BaseType.Name
+					}
+					return postgresType(r, &base, settings)
+				}
 				enum, ok := typ.(*catalog.Enum)
 				if !ok {
 					continue
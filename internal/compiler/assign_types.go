@@ -0,0 +1,301 @@
+package compiler
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/kyleconroy/sqlc/internal/sql/ast"
+	"github.com/kyleconroy/sqlc/internal/sql/catalog"
+	"github.com/kyleconroy/sqlc/internal/sql/sqlerr"
+)
+
+// validateAssignTypes checks that the values inserted into or assigned to
+// columns by INSERT and UPDATE statements can be stored in those columns.
+//
+// https://www.postgresql.org/docs/current/typeconv-query.html
+func validateAssignTypes(qc *QueryCatalog, node ast.Node) error {
+	// MySQL and SQLite convert values on assignment instead of rejecting them
+	if qc.catalog.LooseTypes {
+		return nil
+	}
+	switch n := node.(type) {
+	case *ast.InsertStmt:
+		if err := validateInsertTypes(qc, n); err != nil {
+			return err
+		}
+		if n.OnConflictClause == nil || n.OnConflictClause.TargetList == nil {
+			return nil
+		}
+		tables, err := sourceTables(qc, n)
+		if err != nil {
+			return err
+		}
+		eqc := onConflictScope(qc, tables[0])
+		for _, item := range n.OnConflictClause.TargetList.Items {
+			res, ok := item.(*ast.ResTarget)
+			if !ok || res.Name == nil || (res.Indirection != nil && len(res.Indirection.Items) > 0) {
+				continue
+			}
+			if err := validateAssignment(eqc, findColumn(tables[0], *res.Name), res.Val); err != nil {
+				return err
+			}
+		}
+	case *ast.UpdateStmt:
+		tables, err := sourceTables(qc, n)
+		if err != nil {
+			return err
+		}
+		target := tables[len(tables)-1]
+		eqc := qc.withOuterScope(tables)
+		for _, item := range n.TargetList.Items {
+			res, ok := item.(*ast.ResTarget)
+			if !ok || res.Name == nil || (res.Indirection != nil && len(res.Indirection.Items) > 0) {
+				continue
+			}
+			if err := validateAssignment(eqc, findColumn(target, *res.Name), res.Val); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func validateInsertTypes(qc *QueryCatalog, n *ast.InsertStmt) error {
+	sel, ok := n.SelectStmt.(*ast.SelectStmt)
+	if !ok {
+		return nil
+	}
+	tables, err := sourceTables(qc, n)
+	if err != nil {
+		return err
+	}
+
+	targets := insertTargets(tables[0], n)
+
+	if sel.ValuesLists != nil && len(sel.ValuesLists.Items) > 0 {
+		for _, item := range sel.ValuesLists.Items {
+			row, ok := item.(*ast.List)
+			if !ok {
+				continue
+			}
+			for i, val := range row.Items {
+				if i >= len(targets) {
+					break
+				}
+				if err := validateAssignment(qc, targets[i], val); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	cols, err := outputColumns(qc, sel)
+	if err != nil {
+		return err
+	}
+	for i, col := range cols {
+		if i >= len(targets) {
+			break
+		}
+		if !canAssign(col, targets[i]) {
+			return assignTypeError(targets[i], col, setOperationLocation(sel, i))
+		}
+	}
+	return nil
+}
+
+// insertTargets returns the columns of a table that an INSERT statement
+// assigns values to, in order. Without a column list, values are assigned
+// to the columns of the table in order.
+func insertTargets(table *Table, n *ast.InsertStmt) []*Column {
+	if n.Cols == nil || len(n.Cols.Items) == 0 {
+		return table.Columns
+	}
+	var targets []*Column
+	for _, item := range n.Cols.Items {
+		res, ok := item.(*ast.ResTarget)
+		if !ok || res.Name == nil || (res.Indirection != nil && len(res.Indirection.Items) > 0) {
+			targets = append(targets, nil)
+			continue
+		}
+		targets = append(targets, findColumn(table, *res.Name))
+	}
+	return targets
+}
+
+// validateGeneratedColumns checks that INSERT and UPDATE statements only
+// assign DEFAULT to generated columns, whose values are computed by the
+// database.
+func validateGeneratedColumns(qc *QueryCatalog, node ast.Node) error {
+	switch n := node.(type) {
+	case *ast.InsertStmt:
+		sel, ok := n.SelectStmt.(*ast.SelectStmt)
+		if !ok {
+			return nil
+		}
+		tables, err := sourceTables(qc, n)
+		if err != nil {
+			return err
+		}
+		targets := insertTargets(tables[0], n)
+		if sel.ValuesLists == nil || len(sel.ValuesLists.Items) == 0 {
+			// The values of INSERT ... SELECT can't be DEFAULT
+			for _, target := range targets {
+				if target != nil && target.Generated {
+					return generatedInsertError(target, 0)
+				}
+			}
+			return nil
+		}
+		for _, item := range sel.ValuesLists.Items {
+			row, ok := item.(*ast.List)
+			if !ok {
+				continue
+			}
+			for i, val := range row.Items {
+				if i >= len(targets) || targets[i] == nil || !targets[i].Generated {
+					continue
+				}
+				if _, ok := val.(*ast.SetToDefault); !ok {
+					return generatedInsertError(targets[i], exprLocation(val))
+				}
+			}
+		}
+	case *ast.UpdateStmt:
+		tables, err := sourceTables(qc, n)
+		if err != nil {
+			return err
+		}
+		target := tables[len(tables)-1]
+		for _, item := range n.TargetList.Items {
+			res, ok := item.(*ast.ResTarget)
+			if !ok || res.Name == nil {
+				continue
+			}
+			col := findColumn(target, *res.Name)
+			if col == nil || !col.Generated {
+				continue
+			}
+			if _, ok := res.Val.(*ast.SetToDefault); !ok {
+				return &sqlerr.Error{
+					Code:     "428C9",
+					Message:  fmt.Sprintf("column \"%s\" can only be updated to DEFAULT", col.Name),
+					Location: exprLocation(res.Val),
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func generatedInsertError(target *Column, location int) error {
+	return &sqlerr.Error{
+		Code:     "428C9",
+		Message:  fmt.Sprintf("cannot insert a non-DEFAULT value into column \"%s\"", target.Name),
+		Location: location,
+	}
+}
+
+// validateAssignment checks a single value assigned to a column.
+func validateAssignment(qc *QueryCatalog, target *Column, val ast.Node) error {
+	if target == nil || val == nil {
+		return nil
+	}
+	if c, ok := val.(*ast.A_Const); ok {
+		if s, ok := c.Val.(*ast.String); ok {
+			return validateLiteral(target, s.Str, c.Location)
+		}
+	}
+	col := exprColumn(qc, val)
+	if !canAssign(col, target) {
+		return assignTypeError(target, col, exprLocation(val))
+	}
+	return nil
+}
+
+// canAssign reports whether a value of the given type can be stored in a
+// column. Any value can be stored in a string column; otherwise the types
+// must be in the same category. Types sqlc doesn't know about are allowed.
+func canAssign(from, to *Column) bool {
+	if from == nil || to == nil {
+		return true
+	}
+	catf, catt := catalog.TypeCategory(from.DataType), catalog.TypeCategory(to.DataType)
+	switch {
+	case catf == 'X' || catf == 'U' || catt == 'U':
+		return true
+	case catt == 'S' && !to.IsArray:
+		return true
+	case from.IsArray != to.IsArray:
+		return false
+	}
+	return catf == catt
+}
+
+// validateLiteral checks that a string literal is valid input for the type
+// of a column.
+func validateLiteral(target *Column, val string, location int) error {
+	if target.IsArray {
+		return nil
+	}
+	valid := true
+	switch catalog.TypeCategory(target.DataType) {
+	case 'N':
+		_, err := strconv.ParseFloat(strings.TrimSpace(val), 64)
+		valid = err == nil
+	case 'B':
+		switch strings.ToLower(strings.TrimSpace(val)) {
+		case "t", "true", "y", "yes", "on", "1", "f", "false", "n", "no", "off", "0":
+		default:
+			valid = false
+		}
+	}
+	if valid {
+		return nil
+	}
+	return &sqlerr.Error{
+		Code:     "22P02",
+		Message:  fmt.Sprintf("invalid input syntax for type %s: \"%s\"", catalog.DisplayType(columnType(target)), val),
+		Location: location,
+	}
+}
+
+func assignTypeError(target, col *Column, location int) error {
+	return &sqlerr.Error{
+		Code:     "42804",
+		Message:  fmt.Sprintf("column \"%s\" is of type %s but expression is of type %s", target.Name, catalog.DisplayType(columnType(target)), catalog.DisplayType(columnType(col))),
+		Location: location,
+	}
+}
+
+func findColumn(table *Table, name string) *Column {
+	for _, c := range table.Columns {
+		if c.Name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+// exprLocation returns the location of an expression in the query.
+func exprLocation(node ast.Node) int {
+	switch n := node.(type) {
+	case *ast.A_Const:
+		return n.Location
+	case *ast.A_Expr:
+		return n.Location
+	case *ast.BoolExpr:
+		return n.Location
+	case *ast.ColumnRef:
+		return n.Location
+	case *ast.FuncCall:
+		return n.Location
+	case *ast.ParamRef:
+		return n.Location
+	case *ast.TypeCast:
+		return n.Location
+	}
+	return 0
+}
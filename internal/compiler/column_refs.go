@@ -0,0 +1,333 @@
+package compiler
+
+import (
+	"errors"
+
+	"github.com/kyleconroy/sqlc/internal/sql/ast"
+	"github.com/kyleconroy/sqlc/internal/sql/astutils"
+	"github.com/kyleconroy/sqlc/internal/sql/sqlerr"
+)
+
+// System columns are present in every PostgreSQL table, but aren't part of
+// the catalog.
+//
+// https://www.postgresql.org/docs/current/ddl-system-columns.html
+var systemColumns = map[string]struct{}{
+	"tableoid": {},
+	"xmin":     {},
+	"cmin":     {},
+	"xmax":     {},
+	"cmax":     {},
+	"ctid":     {},
+}
+
+// validateColumnRefs checks that every column reference in a statement, in
+// any clause and in any subquery, refers to exactly one column of the tables
+// in scope.
+func validateColumnRefs(qc *QueryCatalog, node ast.Node) error {
+	switch n := node.(type) {
+	case *ast.SelectStmt:
+		return validateSelectRefs(qc, n)
+
+	case *ast.InsertStmt:
+		if n.SelectStmt != nil {
+			if err := validateColumnRefs(qc, n.SelectStmt); err != nil {
+				return err
+			}
+		}
+		tables, err := sourceTables(qc, n)
+		if err != nil {
+			return err
+		}
+		if n.Cols != nil {
+			for _, item := range n.Cols.Items {
+				if err := validateTargetColumn(tables[0], item); err != nil {
+					return err
+				}
+			}
+		}
+		if n.OnConflictClause != nil {
+			if err := validateOnConflict(qc, n, tables[0]); err != nil {
+				return err
+			}
+		}
+		return validateExprRefs(qc, tables, nil, n.ReturningList)
+
+	case *ast.UpdateStmt:
+		tables, err := sourceTables(qc, n)
+		if err != nil {
+			return err
+		}
+		if err := validateFromRefs(qc, n.FromClause, tables); err != nil {
+			return err
+		}
+		// The relation being updated comes last
+		target := tables[len(tables)-1]
+		for _, item := range n.TargetList.Items {
+			if err := validateTargetColumn(target, item); err != nil {
+				return err
+			}
+			if res, ok := item.(*ast.ResTarget); ok {
+				if err := validateExprRefs(qc, tables, nil, res.Val); err != nil {
+					return err
+				}
+			}
+		}
+		return validateExprRefs(qc, tables, nil, n.WhereClause, n.ReturningList)
+
+	case *ast.DeleteStmt:
+		tables, err := sourceTables(qc, n)
+		if err != nil {
+			return err
+		}
+		// A MySQL DELETE through an outer join can refer to the table being
+		// modified in the conditions of the join
+		from := tables[1:]
+		for _, item := range fromClauseItems(n.UsingClause) {
+			if item == ast.Node(n.Relation) {
+				from = tables
+			}
+		}
+		if err := validateFromRefs(qc, n.UsingClause, from); err != nil {
+			return err
+		}
+		return validateExprRefs(qc, tables, nil, n.WhereClause, n.ReturningList)
+	}
+	return nil
+}
+
+func validateSelectRefs(qc *QueryCatalog, n *ast.SelectStmt) error {
+	if n.WithClause != nil {
+		var err error
+		if qc, err = qc.withCTEs(n.WithClause); err != nil {
+			return err
+		}
+		for _, item := range n.WithClause.Ctes.Items {
+			if cte, ok := item.(*ast.CommonTableExpr); ok {
+				if err := validateColumnRefs(qc, cte.Ctequery); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	// The ORDER BY clause of a set operation can only refer to its output
+	// columns, which are checked when computing them
+	if n.Op != ast.None {
+		if err := validateColumnRefs(qc, n.Larg); err != nil {
+			return err
+		}
+		return validateColumnRefs(qc, n.Rarg)
+	}
+
+	if n.ValuesLists != nil && len(n.ValuesLists.Items) > 0 {
+		return validateExprRefs(qc, nil, nil, n.ValuesLists)
+	}
+
+	tables, err := sourceTables(qc, n)
+	if err != nil {
+		return err
+	}
+	if err := validateFromRefs(qc, n.FromClause, tables); err != nil {
+		return err
+	}
+	using := usingColumns(n.FromClause)
+
+	// GROUP BY, HAVING and ORDER BY may also refer to output columns by name
+	aliases := map[string]struct{}{}
+	if n.TargetList != nil {
+		for _, item := range n.TargetList.Items {
+			if res, ok := item.(*ast.ResTarget); ok && res.Name != nil {
+				aliases[*res.Name] = struct{}{}
+			}
+		}
+	}
+
+	for name := range using {
+		aliases[name] = struct{}{}
+	}
+	if err := validateExprRefs(qc, tables, using, n.TargetList, n.WhereClause, n.WindowClause, n.DistinctClause); err != nil {
+		return err
+	}
+	return validateExprRefs(qc, tables, aliases, n.GroupClause, n.HavingClause, n.SortClause)
+}
+
+// validateFromRefs checks the join conditions, function calls and
+// subqueries of a FROM clause, given the tables it produces. Lateral
+// subqueries and function calls may refer to the items before them.
+func validateFromRefs(qc *QueryCatalog, from *ast.List, tables []*Table) error {
+	if from == nil {
+		return nil
+	}
+	using := usingColumns(from)
+	var err error
+	astutils.Walk(astutils.VisitorFunc(func(node ast.Node) {
+		if join, ok := node.(*ast.JoinExpr); ok && join.Quals != nil && err == nil {
+			err = validateExprRefs(qc, tables, using, join.Quals)
+		}
+	}), from)
+	if err != nil {
+		return err
+	}
+
+	for i, item := range fromClauseItems(from) {
+		if i > len(tables) {
+			break
+		}
+		prior := tables[:i]
+		switch n := item.(type) {
+		case *ast.RangeSubselect:
+			sqc := qc
+			if n.Lateral {
+				sqc = qc.withOuterScope(prior)
+			}
+			if err := validateColumnRefs(sqc, n.Subquery); err != nil {
+				return err
+			}
+		case *ast.RangeFunction:
+			if err := validateExprRefs(qc, prior, nil, n.Functions); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// usingColumns returns the names of the columns merged by the USING clauses
+// of the joins in a FROM clause, which may be referenced without being
+// qualified. A natural join merges columns that can't be named here, so
+// any column may be referenced unqualified.
+func usingColumns(from *ast.List) map[string]struct{} {
+	names := map[string]struct{}{}
+	if from == nil {
+		return names
+	}
+	astutils.Walk(astutils.VisitorFunc(func(node ast.Node) {
+		join, ok := node.(*ast.JoinExpr)
+		if !ok {
+			return
+		}
+		if join.IsNatural {
+			names["*"] = struct{}{}
+		}
+		if join.UsingClause != nil {
+			for _, name := range stringSlice(join.UsingClause) {
+				names[name] = struct{}{}
+			}
+		}
+	}), from)
+	return names
+}
+
+// validateTargetColumn checks that a column being inserted or updated
+// exists in the target table.
+func validateTargetColumn(table *Table, node ast.Node) error {
+	res, ok := node.(*ast.ResTarget)
+	if !ok || res.Name == nil {
+		return nil
+	}
+	for _, c := range table.Columns {
+		if c.Name == *res.Name {
+			return nil
+		}
+	}
+	err := sqlerr.ColumnNotFound(table.Rel.Name, *res.Name)
+	err.Location = res.Location
+	return err
+}
+
+// validateExprRefs checks the column references in expressions against the
+// given tables and the enclosing scopes of the query catalog.
+func validateExprRefs(qc *QueryCatalog, tables []*Table, aliases map[string]struct{}, nodes ...ast.Node) error {
+	v := &columnRefVisitor{qc: qc, tables: tables, aliases: aliases}
+	for _, node := range nodes {
+		if list, ok := node.(*ast.List); node == nil || ok && list == nil {
+			continue
+		}
+		astutils.Walk(v, node)
+		if v.err != nil {
+			return v.err
+		}
+	}
+	return nil
+}
+
+type columnRefVisitor struct {
+	qc      *QueryCatalog
+	tables  []*Table
+	aliases map[string]struct{}
+	err     error
+}
+
+func (v *columnRefVisitor) Visit(node ast.Node) astutils.Visitor {
+	if v.err != nil {
+		return nil
+	}
+	switch n := node.(type) {
+	case *ast.SubLink:
+		if n.Testexpr != nil {
+			astutils.Walk(v, n.Testexpr)
+		}
+		if v.err == nil {
+			v.err = validateColumnRefs(v.qc.withOuterScope(v.tables), n.Subselect)
+		}
+		return nil
+
+	case *ast.SelectStmt:
+		v.err = validateColumnRefs(v.qc.withOuterScope(v.tables), n)
+		return nil
+
+	case *ast.FuncCall:
+		// The arguments to sqlc.arg are parameter names
+		if n.Func != nil && n.Func.Schema == "sqlc" {
+			return nil
+		}
+
+	case *ast.ColumnRef:
+		v.err = v.check(n)
+		return nil
+	}
+	return v
+}
+
+func (v *columnRefVisitor) check(ref *ast.ColumnRef) error {
+	if hasStarRef(ref) {
+		return nil
+	}
+	parts := stringSlice(ref.Fields)
+	if len(parts) == 1 {
+		if _, ok := v.aliases[parts[0]]; ok {
+			return nil
+		}
+		if _, ok := systemColumns[parts[0]]; ok {
+			return nil
+		}
+		// A table name on its own refers to the whole row
+		for _, tables := range v.qc.scopes(v.tables) {
+			for _, t := range tables {
+				if t.Rel.Name == parts[0] {
+					return nil
+				}
+			}
+		}
+	}
+	_, err := outputColumnRefs(v.qc.catalog, &ast.ResTarget{Location: ref.Location}, v.qc.scopes(v.tables), ref)
+	var serr *sqlerr.Error
+	if !errors.As(err, &serr) {
+		return err
+	}
+	name := parts[len(parts)-1]
+	if _, ok := systemColumns[name]; ok && serr.Code != "42P01" {
+		return nil
+	}
+	// Columns merged by a USING clause are in both tables of the join
+	if errors.Is(serr, sqlerr.Ambiguous) {
+		if _, ok := v.aliases[name]; ok {
+			return nil
+		}
+		if _, ok := v.aliases["*"]; ok {
+			return nil
+		}
+	}
+	return err
+}
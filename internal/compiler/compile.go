@@ -60,7 +60,11 @@ func parseCatalog(p Parser, c *catalog.Catalog, schemas []string) error {
 		return err
 	}
 	merr := multierr.New()
+	def := c.DefaultSchema
 	for _, filename := range files {
+		// Each file starts in the default schema, whatever USE statements
+		// earlier files had
+		c.DefaultSchema = def
 		blob, err := ioutil.ReadFile(filename)
 		if err != nil {
 			merr.Add(filename, "", 0, err)
@@ -79,6 +83,7 @@ func parseCatalog(p Parser, c *catalog.Catalog, schemas []string) error {
 			}
 		}
 	}
+	c.DefaultSchema = def
 	if len(merr.Errs()) > 0 {
 		return merr
 	}
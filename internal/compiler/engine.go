@@ -28,6 +28,9 @@ func NewCompiler(conf config.SQL, combo config.CombinedSettings) *Compiler {
 	case config.EngineMySQL:
 		c.parser = dolphin.NewParser()
 		c.catalog = dolphin.NewCatalog()
+	case config.EngineMariaDB:
+		c.parser = dolphin.NewMariaDBParser()
+		c.catalog = dolphin.NewMariaDBCatalog()
 	case config.EnginePostgreSQL:
 		c.parser = postgresql.NewParser()
 		c.catalog = postgresql.NewCatalog()
@@ -0,0 +1,181 @@
+package compiler
+
+import (
+	"github.com/kyleconroy/sqlc/internal/sql/ast"
+	"github.com/kyleconroy/sqlc/internal/sql/catalog"
+)
+
+// exprColumn returns the type of an expression, if it can be determined
+// without evaluating it. Column references are resolved against the tables
+// of the query catalog's scopes.
+func exprColumn(qc *QueryCatalog, node ast.Node) *Column {
+	switch n := node.(type) {
+	case *ast.TypeCast:
+		if n.TypeName == nil {
+			return nil
+		}
+		return toColumn(n.TypeName)
+	case *ast.ColumnRef:
+		if hasStarRef(n) {
+			return nil
+		}
+		cols, err := outputColumnRefs(qc.catalog, &ast.ResTarget{}, qc.scopes(nil), n)
+		if err != nil || len(cols) != 1 {
+			return nil
+		}
+		return cols[0]
+	case *ast.A_Const:
+		if tn := catalog.ExprType(n); tn != nil {
+			return &Column{DataType: tn.Name, NotNull: true}
+		}
+	case *ast.A_Expr:
+		return operatorColumn(qc, n)
+	case *ast.A_Indirection:
+		return indirectionColumn(qc, n)
+	case *ast.BoolExpr:
+		return &Column{DataType: "bool"}
+	case *ast.SubLink:
+		return subLinkColumn(qc, n)
+	case *ast.FuncCall:
+		fun, err := resolveFuncCall(qc, n)
+		if err != nil || fun.ReturnType == nil {
+			return nil
+		}
+		return funcCallColumn(qc, fun, n)
+	}
+	return nil
+}
+
+// operatorColumn returns the result type of an operator expression, such as
+// `price * quantity` or `data->>'name'`.
+func operatorColumn(qc *QueryCatalog, n *ast.A_Expr) *Column {
+	switch n.Kind {
+	case ast.AEXPR_OP:
+	case ast.AEXPR_PAREN:
+		return exprColumn(qc, n.Lexpr)
+	case ast.AEXPR_NULLIF:
+		left := exprColumn(qc, n.Lexpr)
+		if left == nil {
+			return nil
+		}
+		col := *left
+		col.NotNull = false
+		return &col
+	case ast.AEXPR_DISTINCT, ast.AEXPR_NOT_DISTINCT:
+		// IS DISTINCT FROM compares NULLs like any other value
+		return &Column{DataType: "bool", NotNull: true}
+	default:
+		// IN, LIKE, BETWEEN and the like are all predicates, which are NULL
+		// when one of their operands is
+		notNull := true
+		for _, operand := range append([]ast.Node{n.Lexpr}, listItems(n.Rexpr)...) {
+			notNull = notNull && operandNotNull(operand, exprColumn(qc, operand))
+		}
+		return &Column{DataType: "bool", NotNull: notNull}
+	}
+
+	var left, right *Column
+	if n.Lexpr != nil {
+		left = exprColumn(qc, n.Lexpr)
+	}
+	right = exprColumn(qc, n.Rexpr)
+	op, err := qc.catalog.ResolveOperator(n, columnType(left), columnType(right))
+	if err != nil {
+		return nil
+	}
+
+	// A NULL operand makes the result NULL
+	notNull := operandNotNull(n.Rexpr, right)
+	if n.Lexpr != nil {
+		notNull = notNull && operandNotNull(n.Lexpr, left)
+	}
+
+	switch dataType(op.ReturnType) {
+	case "anyarray", "anyelement", "anynonarray":
+		for _, operand := range []*Column{left, right} {
+			if operand != nil && operand.IsArray {
+				col := *operand
+				col.Name = ""
+				col.NotNull = notNull
+				return &col
+			}
+		}
+		return nil
+	case "anyrange", "anymultirange":
+		for _, operand := range []*Column{left, right} {
+			if operand == nil || operand.IsArray {
+				continue
+			}
+			if r, _ := qc.catalog.LookupRange(columnType(operand)); r != nil {
+				col := *operand
+				col.Name = ""
+				col.NotNull = notNull
+				return &col
+			}
+		}
+		return nil
+	}
+	return &Column{
+		DataType: dataType(op.ReturnType),
+		NotNull:  notNull,
+		IsArray:  isArray(op.ReturnType),
+	}
+}
+
+// operandNotNull reports whether an operand of type col can't be NULL.
+// Operands whose type isn't known, such as a CASE or a NULL literal, may be,
+// unlike other literals and parameters.
+func operandNotNull(node ast.Node, col *Column) bool {
+	if col != nil {
+		return col.NotNull
+	}
+	switch n := node.(type) {
+	case *ast.A_Const:
+		_, null := n.Val.(*ast.Null)
+		return !null
+	case *ast.ParamRef:
+		return true
+	}
+	return false
+}
+
+// subLinkColumn returns the result of a subquery used as an expression. A
+// scalar subquery is NULL when it returns no rows.
+func subLinkColumn(qc *QueryCatalog, n *ast.SubLink) *Column {
+	switch n.SubLinkType {
+	case ast.EXISTS_SUBLINK:
+		return &Column{DataType: "bool", NotNull: true}
+	case ast.EXPR_SUBLINK:
+		cols, err := outputColumns(qc, n.Subselect)
+		if err != nil || len(cols) != 1 {
+			return nil
+		}
+		col := *cols[0]
+		col.Name = ""
+		col.NotNull = false
+		return &col
+	}
+	return nil
+}
+
+// listItems returns the items of a list, such as the values of IN (...) or
+// the bounds of BETWEEN, or the node itself if it isn't one.
+func listItems(node ast.Node) []ast.Node {
+	if l, ok := node.(*ast.List); ok {
+		return l.Items
+	}
+	return []ast.Node{node}
+}
+
+// columnType returns the type of a column as a type name, or nil if the
+// type isn't known.
+func columnType(col *Column) *ast.TypeName {
+	if col == nil || col.DataType == "any" {
+		return nil
+	}
+	tn := &ast.TypeName{Name: col.DataType}
+	if col.IsArray {
+		tn.Name += "[]"
+	}
+	return tn
+}
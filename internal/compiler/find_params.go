@@ -44,6 +44,38 @@ func (l *limitOffset) Pos() int {
 	return 0
 }
 
+// A frameOffset is the parent of a parameter giving the number of rows
+// before or after the current row in a window frame, e.g.
+// `ROWS BETWEEN $1 PRECEDING AND CURRENT ROW`.
+type frameOffset struct {
+	name string
+}
+
+func (f *frameOffset) Pos() int {
+	return 0
+}
+
+// An insertPosition is the parent of a parameter in the VALUES of an INSERT
+// statement without a column list, which is assigned to the column of the
+// table at index.
+type insertPosition struct {
+	index int
+}
+
+func (p *insertPosition) Pos() int {
+	return 0
+}
+
+// A variableValue is the parent of a parameter assigned to a variable by a
+// MySQL SET statement, e.g. `SET @@session.time_zone = ?`.
+type variableValue struct {
+	name string
+}
+
+func (v *variableValue) Pos() int {
+	return 0
+}
+
 func (p paramSearch) Visit(node ast.Node) astutils.Visitor {
 	switch n := node.(type) {
 
@@ -54,6 +86,16 @@ func (p paramSearch) Visit(node ast.Node) astutils.Visitor {
 		p.parent = node
 
 	case *ast.InsertStmt:
+		// Parameters assigned in an ON CONFLICT clause take the type of a
+		// column of the inserted table
+		p.rangeVar = n.Relation
+		column := func(i int) ast.Node {
+			if n.Cols != nil && len(n.Cols.Items) > 0 {
+				// TODO: Out-of-bounds panic
+				return n.Cols.Items[i]
+			}
+			return &insertPosition{index: i}
+		}
 		if s, ok := n.SelectStmt.(*ast.SelectStmt); ok {
 			for i, item := range s.TargetList.Items {
 				target, ok := item.(*ast.ResTarget)
@@ -64,8 +106,7 @@ func (p paramSearch) Visit(node ast.Node) astutils.Visitor {
 				if !ok {
 					continue
 				}
-				// TODO: Out-of-bounds panic
-				*p.refs = append(*p.refs, paramRef{parent: n.Cols.Items[i], ref: ref, rv: n.Relation})
+				*p.refs = append(*p.refs, paramRef{parent: column(i), ref: ref, rv: n.Relation})
 				p.seen[ref.Location] = struct{}{}
 			}
 			for _, item := range s.ValuesLists.Items {
@@ -78,8 +119,7 @@ func (p paramSearch) Visit(node ast.Node) astutils.Visitor {
 					if !ok {
 						continue
 					}
-					// TODO: Out-of-bounds panic
-					*p.refs = append(*p.refs, paramRef{parent: n.Cols.Items[i], ref: ref, rv: n.Relation})
+					*p.refs = append(*p.refs, paramRef{parent: column(i), ref: ref, rv: n.Relation})
 					p.seen[ref.Location] = struct{}{}
 				}
 			}
@@ -102,6 +142,43 @@ func (p paramSearch) Visit(node ast.Node) astutils.Visitor {
 	case *ast.TypeCast:
 		p.parent = node
 
+	case *ast.VariableSetStmt:
+		if n.Args == nil {
+			break
+		}
+		for _, item := range n.Args.Items {
+			res, ok := item.(*ast.ResTarget)
+			if !ok || res.Name == nil {
+				continue
+			}
+			ref, ok := res.Val.(*ast.ParamRef)
+			if !ok {
+				continue
+			}
+			*p.refs = append(*p.refs, paramRef{parent: &variableValue{name: *res.Name}, ref: ref})
+			p.seen[ref.Location] = struct{}{}
+		}
+
+	case *ast.WindowDef:
+		offsets := []struct {
+			node ast.Node
+			name string
+		}{
+			{n.StartOffset, "start_offset"},
+			{n.EndOffset, "end_offset"},
+		}
+		for _, offset := range offsets {
+			ref, ok := offset.node.(*ast.ParamRef)
+			if !ok {
+				continue
+			}
+			if _, found := p.seen[ref.Location]; found {
+				continue
+			}
+			*p.refs = append(*p.refs, paramRef{parent: &frameOffset{name: offset.name}, ref: ref, rv: p.rangeVar})
+			p.seen[ref.Location] = struct{}{}
+		}
+
 	case *ast.ParamRef:
 		parent := p.parent
 
@@ -0,0 +1,93 @@
+package compiler
+
+import (
+	"strings"
+
+	"github.com/kyleconroy/sqlc/internal/sql/ast"
+	"github.com/kyleconroy/sqlc/internal/sql/catalog"
+)
+
+// resolveFuncCall finds the function a call refers to, choosing between
+// overloads using the types of the arguments. Column references are
+// resolved against the tables of the query catalog's scopes.
+func resolveFuncCall(qc *QueryCatalog, call *ast.FuncCall) (*catalog.Function, error) {
+	var types []*ast.TypeName
+	if call.Args != nil {
+		for _, arg := range call.Args.Items {
+			if n, ok := arg.(*ast.NamedArgExpr); ok {
+				arg = n.Arg
+			}
+			types = append(types, columnType(exprColumn(qc, arg)))
+		}
+	}
+	return qc.catalog.ResolveFuncCallTypes(call, types)
+}
+
+// Window functions returning a value from another row of the window frame
+// evaluate to NULL when there's no such row.
+//
+// https://www.postgresql.org/docs/current/functions-window.html
+var windowValueFuncs = map[string]bool{
+	"lag":         true,
+	"lead":        true,
+	"first_value": true,
+	"last_value":  true,
+	"nth_value":   true,
+}
+
+// funcCallColumn returns the result of a call to a resolved function.
+func funcCallColumn(qc *QueryCatalog, fun *catalog.Function, call *ast.FuncCall) *Column {
+	col := polymorphicReturn(qc, fun, call)
+	if call.Over != nil && windowValueFuncs[strings.ToLower(fun.Name)] {
+		col.NotNull = false
+	}
+	var args []ast.Node
+	if call.Args != nil {
+		args = call.Args.Items
+	}
+	switch {
+	case fun.ReturnTypeNullable:
+		col.NotNull = false
+	case !qc.catalog.NullableFuncs || strings.EqualFold(fun.Name, "count"):
+		// COUNT is never NULL, whatever it counts
+	case fun.Strict:
+		col.NotNull = col.NotNull && argsNotNull(qc, args)
+	default:
+		col.NotNull = false
+	}
+	// The result of the MySQL flow control functions, GREATEST and LEAST
+	// follows the arguments that were passed. CONCAT_WS skips NULL values,
+	// so only a NULL separator makes it NULL.
+	//
+	// https://dev.mysql.com/doc/refman/8.0/en/flow-control-functions.html
+	// https://dev.mysql.com/doc/refman/8.0/en/string-functions.html#function_concat-ws
+	switch strings.ToLower(fun.Name) {
+	case "concat_ws":
+		if len(args) > 0 {
+			col.NotNull = argsNotNull(qc, args[:1])
+		}
+	case "nullif":
+		col.NotNull = false
+	case "if":
+		if len(args) == 3 {
+			col.NotNull = argsNotNull(qc, args[1:])
+		}
+	case "ifnull":
+		if len(args) == 2 {
+			col.NotNull = argsNotNull(qc, args[:1]) || argsNotNull(qc, args[1:])
+		}
+	case "greatest", "least":
+		col.NotNull = argsNotNull(qc, args)
+	}
+	return col
+}
+
+// argsNotNull reports whether none of the arguments can be NULL.
+func argsNotNull(qc *QueryCatalog, args []ast.Node) bool {
+	for _, arg := range args {
+		if !operandNotNull(arg, exprColumn(qc, arg)) {
+			return false
+		}
+	}
+	return true
+}
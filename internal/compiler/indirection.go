@@ -0,0 +1,88 @@
+package compiler
+
+import (
+	"github.com/kyleconroy/sqlc/internal/sql/ast"
+	"github.com/kyleconroy/sqlc/internal/sql/catalog"
+)
+
+// indirectionColumn returns the type of a field selection, such as
+// `(address).city`, or an array subscript, such as `tags[1]`.
+//
+// https://www.postgresql.org/docs/current/sql-expressions.html#FIELD-SELECTION
+func indirectionColumn(qc *QueryCatalog, n *ast.A_Indirection) *Column {
+	col := exprColumn(qc, n.Arg)
+	if col == nil {
+		return nil
+	}
+	return applyIndirection(qc.catalog, col, n.Indirection.Items)
+}
+
+// unwrapIndirection returns the innermost argument of nested field
+// selections, such as `((location).address).city`, and the fields and
+// subscripts applied to it in order.
+func unwrapIndirection(n *ast.A_Indirection) (ast.Node, []ast.Node) {
+	var items []ast.Node
+	var arg ast.Node = n
+	for {
+		ind, ok := arg.(*ast.A_Indirection)
+		if !ok {
+			return arg, items
+		}
+		if ind.Indirection != nil {
+			items = append(append([]ast.Node{}, ind.Indirection.Items...), items...)
+		}
+		arg = ind.Arg
+	}
+}
+
+func applyIndirection(c *catalog.Catalog, col *Column, items []ast.Node) *Column {
+	out := *col
+	for _, item := range items {
+		switch i := item.(type) {
+		case *ast.A_Indices:
+			if !out.IsArray {
+				return nil
+			}
+			out.IsArray = i.IsSlice
+			out.NotNull = false
+		case *ast.String:
+			if out.IsArray {
+				return nil
+			}
+			field := compositeField(c, out.DataType, i.Str)
+			if field == nil {
+				return nil
+			}
+			out = Column{
+				Name:     field.Name,
+				DataType: dataType(&field.Type),
+				IsArray:  field.IsArray,
+			}
+		default:
+			return nil
+		}
+	}
+	return &out
+}
+
+// compositeField finds a field of a composite type.
+func compositeField(c *catalog.Catalog, dt, name string) *catalog.Column {
+	rel, err := ParseRelationString(dt)
+	if err != nil {
+		return nil
+	}
+	typ, err := c.GetType(&ast.TypeName{Schema: rel.Schema, Name: rel.Name})
+	if err != nil {
+		return nil
+	}
+	ct, ok := typ.(*catalog.CompositeType)
+	if !ok {
+		return nil
+	}
+	for _, col := range ct.Columns {
+		if col.Name == name {
+			return col
+		}
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+package compiler
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/kyleconroy/sqlc/internal/sql/ast"
+	"github.com/kyleconroy/sqlc/internal/sql/catalog"
+	"github.com/kyleconroy/sqlc/internal/sql/sqlerr"
+)
+
+// excludedTable returns the EXCLUDED pseudo-table of an ON CONFLICT clause,
+// which holds the row proposed for insertion. MySQL's VALUES(col) function
+// is converted to a reference to it as well.
+//
+// https://www.postgresql.org/docs/current/sql-insert.html#SQL-ON-CONFLICT
+func excludedTable(target *Table) *Table {
+	rel := &ast.TableName{Name: "excluded"}
+	var cols []*Column
+	for _, c := range target.Columns {
+		col := *c
+		col.Table = rel
+		cols = append(cols, &col)
+	}
+	return &Table{Rel: rel, Columns: cols}
+}
+
+// onConflictScope returns a query catalog in which column references of an
+// ON CONFLICT clause can be resolved. Unqualified names refer to the target
+// table; the EXCLUDED table must always be named.
+func onConflictScope(qc *QueryCatalog, target *Table) *QueryCatalog {
+	return qc.withOuterScope([]*Table{excludedTable(target)}).withOuterScope([]*Table{target})
+}
+
+// validateOnConflict checks the column references of an ON CONFLICT clause
+// and that its conflict target matches a unique constraint of the table.
+func validateOnConflict(qc *QueryCatalog, n *ast.InsertStmt, target *Table) error {
+	oc := n.OnConflictClause
+	eqc := onConflictScope(qc, target)
+	if oc.TargetList != nil {
+		for _, item := range oc.TargetList.Items {
+			if err := validateTargetColumn(target, item); err != nil {
+				return err
+			}
+			if res, ok := item.(*ast.ResTarget); ok {
+				if err := validateExprRefs(eqc, nil, nil, res.Val); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if err := validateExprRefs(eqc, nil, nil, oc.WhereClause); err != nil {
+		return err
+	}
+	if oc.Infer == nil {
+		return nil
+	}
+	if err := validateExprRefs(eqc, nil, nil, oc.Infer.WhereClause); err != nil {
+		return err
+	}
+
+	fqn, err := ParseTableName(n.Relation)
+	if err != nil {
+		return err
+	}
+	table, err := qc.catalog.GetTable(fqn)
+	if err != nil {
+		return err
+	}
+
+	if oc.Infer.Conname != nil {
+		for _, con := range table.Constraints {
+			if con.Name == *oc.Infer.Conname {
+				return nil
+			}
+		}
+		return &sqlerr.Error{
+			Code:     "42704",
+			Message:  fmt.Sprintf("constraint \"%s\" for table \"%s\" does not exist", *oc.Infer.Conname, fqn.Name),
+			Location: oc.Infer.Location,
+		}
+	}
+
+	var cols []string
+	for _, item := range oc.Infer.IndexElems.Items {
+		elem, ok := item.(*ast.IndexElem)
+		if !ok || elem.Name == nil {
+			// Expression indexes aren't tracked by the catalog
+			return nil
+		}
+		if findColumn(target, *elem.Name) == nil {
+			return &sqlerr.Error{
+				Code:     "42703",
+				Message:  fmt.Sprintf("column \"%s\" does not exist", *elem.Name),
+				Location: oc.Infer.Location,
+			}
+		}
+		cols = append(cols, *elem.Name)
+	}
+	for _, con := range table.Constraints {
+		if isArbiter(con, cols) {
+			return nil
+		}
+	}
+	return &sqlerr.Error{
+		Code:     "42P10",
+		Message:  "there is no unique or exclusion constraint matching the ON CONFLICT specification",
+		Location: oc.Infer.Location,
+	}
+}
+
+// isArbiter reports whether a constraint can be used to detect conflicts on
+// the given columns, in any order.
+func isArbiter(con *catalog.Constraint, cols []string) bool {
+	if con.Type != ast.CONSTR_PRIMARY && con.Type != ast.CONSTR_UNIQUE {
+		return false
+	}
+	if len(con.Columns) != len(cols) {
+		return false
+	}
+	a := append([]string{}, con.Columns...)
+	b := append([]string{}, cols...)
+	sort.Strings(a)
+	sort.Strings(b)
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -3,10 +3,11 @@ package compiler
 import (
 	"errors"
 	"fmt"
+	"math"
 
 	"github.com/kyleconroy/sqlc/internal/sql/ast"
 	"github.com/kyleconroy/sqlc/internal/sql/astutils"
-	"github.com/kyleconroy/sqlc/internal/sql/lang"
+	"github.com/kyleconroy/sqlc/internal/sql/catalog"
 	"github.com/kyleconroy/sqlc/internal/sql/sqlerr"
 )
 
@@ -24,6 +25,10 @@ func hasStarRef(cf *ast.ColumnRef) bool {
 // Return an error if column references are ambiguous
 // Return an error if column references don't exist
 func outputColumns(qc *QueryCatalog, node ast.Node) ([]*Column, error) {
+	if n, ok := node.(*ast.SelectStmt); ok && n.Op != ast.None {
+		return setOperationColumns(qc, n)
+	}
+
 	tables, err := sourceTables(qc, node)
 	if err != nil {
 		return nil, err
@@ -39,6 +44,8 @@ func outputColumns(qc *QueryCatalog, node ast.Node) ([]*Column, error) {
 		targets = n.TargetList
 	case *ast.TruncateStmt:
 		targets = &ast.List{}
+	case *ast.CreateTableStmt, *ast.LockStmt, *ast.NotifyStmt, *ast.RefreshMatViewStmt, *ast.VacuumStmt, *ast.VariableSetStmt:
+		targets = &ast.List{}
 	case *ast.UpdateStmt:
 		targets = n.ReturningList
 	default:
@@ -54,21 +61,47 @@ func outputColumns(qc *QueryCatalog, node ast.Node) ([]*Column, error) {
 		}
 		switch n := res.Val.(type) {
 
-		case *ast.A_Expr:
+		case *ast.A_Const:
 			name := ""
 			if res.Name != nil {
 				name = *res.Name
 			}
-			switch {
-			case lang.IsComparisonOperator(astutils.Join(n.Name, "")):
-				// TODO: Generate a name for these operations
-				cols = append(cols, &Column{Name: name, DataType: "bool", NotNull: true})
-			case lang.IsMathematicalOperator(astutils.Join(n.Name, "")):
-				cols = append(cols, &Column{Name: name, DataType: "int", NotNull: true})
+			switch v := n.Val.(type) {
+			case *ast.Integer:
+				dt := "int4"
+				if v.Ival > math.MaxInt32 || v.Ival < math.MinInt32 {
+					dt = "int8"
+				}
+				cols = append(cols, &Column{Name: name, DataType: dt, NotNull: true})
+			case *ast.Float:
+				cols = append(cols, &Column{Name: name, DataType: "numeric", NotNull: true})
 			default:
 				cols = append(cols, &Column{Name: name, DataType: "any", NotNull: false})
 			}
 
+		case *ast.A_Expr:
+			name := ""
+			if res.Name != nil {
+				name = *res.Name
+			}
+			// TODO: Generate a name for these operations
+			if col := operatorColumn(qc.withOuterScope(tables), n); col != nil {
+				col.Name = name
+				cols = append(cols, col)
+			} else {
+				cols = append(cols, &Column{Name: name, DataType: "any", NotNull: false})
+			}
+
+		case *ast.A_Indirection:
+			col := indirectionColumn(qc.withOuterScope(tables), n)
+			if col == nil {
+				col = &Column{DataType: "any"}
+			}
+			if res.Name != nil {
+				col.Name = *res.Name
+			}
+			cols = append(cols, col)
+
 		case *ast.CaseExpr:
 			name := ""
 			if res.Name != nil {
@@ -101,7 +134,7 @@ func outputColumns(qc *QueryCatalog, node ast.Node) ([]*Column, error) {
 					continue
 				}
 				if ref, ok := arg.(*ast.ColumnRef); ok {
-					columns, err := outputColumnRefs(res, tables, ref)
+					columns, err := outputColumnRefs(qc.catalog, res, qc.scopes(tables), ref)
 					if err != nil {
 						return nil, err
 					}
@@ -137,13 +170,15 @@ func outputColumns(qc *QueryCatalog, node ast.Node) ([]*Column, error) {
 							DataType: c.DataType,
 							NotNull:  c.NotNull,
 							IsArray:  c.IsArray,
+							Unsigned: c.Unsigned,
+							Length:   c.Length,
 						})
 					}
 				}
 				continue
 			}
 
-			columns, err := outputColumnRefs(res, tables, n)
+			columns, err := outputColumnRefs(qc.catalog, res, qc.scopes(tables), n)
 			if err != nil {
 				return nil, err
 			}
@@ -155,10 +190,16 @@ func outputColumns(qc *QueryCatalog, node ast.Node) ([]*Column, error) {
 			if res.Name != nil {
 				name = *res.Name
 			}
-			fun, err := qc.catalog.ResolveFuncCall(n)
-			if err == nil {
-				cols = append(cols, &Column{Name: name, DataType: dataType(fun.ReturnType), NotNull: true})
-			} else {
+			fun, err := resolveFuncCall(qc.withOuterScope(tables), n)
+			var serr *sqlerr.Error
+			switch {
+			case err == nil && fun.ReturnType != nil:
+				col := funcCallColumn(qc.withOuterScope(tables), fun, n)
+				col.Name = name
+				cols = append(cols, col)
+			case errors.As(err, &serr) && serr.Code == "42883":
+				return nil, err
+			default:
 				cols = append(cols, &Column{Name: name, DataType: "any"})
 			}
 
@@ -202,6 +243,90 @@ func outputColumns(qc *QueryCatalog, node ast.Node) ([]*Column, error) {
 	return cols, nil
 }
 
+// Compute the output columns for a set operation (UNION, INTERSECT or
+// EXCEPT). The columns of each branch are matched up by position; names come
+// from the left-most branch.
+//
+// Return an error if the branches have a different number of columns
+// Return an error if the column types of the branches can't be matched
+func setOperationColumns(qc *QueryCatalog, n *ast.SelectStmt) ([]*Column, error) {
+	if n.Larg == nil || n.Rarg == nil {
+		return nil, fmt.Errorf("setOperationColumns: %s is missing an argument", n.Op)
+	}
+	left, err := outputColumns(qc, n.Larg)
+	if err != nil {
+		return nil, err
+	}
+	right, err := outputColumns(qc, n.Rarg)
+	if err != nil {
+		return nil, err
+	}
+	if len(left) != len(right) {
+		return nil, &sqlerr.Error{
+			Code:     "42601",
+			Message:  fmt.Sprintf("each %s query must have the same number of columns", n.Op),
+			Location: setOperationLocation(n.Rarg, 0),
+		}
+	}
+	cols := make([]*Column, len(left))
+	for i := range left {
+		l, r := left[i], right[i]
+		if l.IsArray != r.IsArray {
+			return nil, setOperationTypeError(n, l, r, i)
+		}
+		winner, ok := catalog.CommonType(l.DataType, r.DataType)
+		if !ok {
+			return nil, setOperationTypeError(n, l, r, i)
+		}
+		col := *l
+		if winner == 1 {
+			col = *r
+			col.Name = l.Name
+		}
+		// Rows returned by EXCEPT always come from the left branch, while a
+		// row returned by INTERSECT must exist in both branches.
+		switch n.Op {
+		case ast.Union:
+			col.NotNull = l.NotNull && r.NotNull
+		case ast.Intersect:
+			col.NotNull = l.NotNull || r.NotNull
+		case ast.Except:
+			col.NotNull = l.NotNull
+		}
+		if col.Table != nil && (l.Table == nil || r.Table == nil || l.Table.Name != r.Table.Name) {
+			col.Table = nil
+		}
+		cols[i] = &col
+	}
+	return cols, nil
+}
+
+func setOperationTypeError(n *ast.SelectStmt, l, r *Column, i int) error {
+	return &sqlerr.Error{
+		Code:     "42804",
+		Message:  fmt.Sprintf("%s types %s and %s cannot be matched", n.Op, displayType(l), displayType(r)),
+		Location: setOperationLocation(n.Rarg, i),
+	}
+}
+
+// setOperationLocation returns the location of the i-th target of the
+// left-most SELECT in a set operation branch.
+func setOperationLocation(n *ast.SelectStmt, i int) int {
+	for n.Op != ast.None && n.Larg != nil {
+		n = n.Larg
+	}
+	if n.TargetList == nil || len(n.TargetList.Items) == 0 {
+		return 0
+	}
+	if i >= len(n.TargetList.Items) {
+		i = 0
+	}
+	if res, ok := n.TargetList.Items[i].(*ast.ResTarget); ok {
+		return res.Location
+	}
+	return 0
+}
+
 // Compute the output columns for a statement.
 //
 // Return an error if column references are ambiguous
@@ -213,21 +338,16 @@ func sourceTables(qc *QueryCatalog, node ast.Node) ([]*Table, error) {
 	switch n := node.(type) {
 	case *ast.DeleteStmt:
 		list = &ast.List{
-			Items: []ast.Node{n.Relation},
+			Items: append([]ast.Node{n.Relation}, otherItems(n.UsingClause, n.Relation)...),
 		}
 	case *ast.InsertStmt:
 		list = &ast.List{
 			Items: []ast.Node{n.Relation},
 		}
 	case *ast.SelectStmt:
-		list = astutils.Search(n.FromClause, func(node ast.Node) bool {
-			switch node.(type) {
-			case *ast.RangeVar, *ast.RangeSubselect:
-				return true
-			default:
-				return false
-			}
-		})
+		list = &ast.List{
+			Items: fromClauseItems(n.FromClause),
+		}
 	case *ast.TruncateStmt:
 		list = astutils.Search(n.Relations, func(node ast.Node) bool {
 			_, ok := node.(*ast.RangeVar)
@@ -235,8 +355,20 @@ func sourceTables(qc *QueryCatalog, node ast.Node) ([]*Table, error) {
 		})
 	case *ast.UpdateStmt:
 		list = &ast.List{
-			Items: append(n.FromClause.Items, n.Relation),
+			Items: append(otherItems(n.FromClause, n.Relation), n.Relation),
+		}
+	case *ast.LockStmt:
+		list = n.Relations
+	case *ast.VacuumStmt:
+		list = &ast.List{}
+		if n.Relation != nil {
+			list.Items = append(list.Items, n.Relation)
 		}
+	case *ast.CreateTableStmt, *ast.NotifyStmt, *ast.RefreshMatViewStmt, *ast.VariableSetStmt:
+		// None of these reference a table in the catalog: CREATE TABLE
+		// defines a new one, REFRESH names a materialized view, which the
+		// catalog doesn't track, and NOTIFY and SET don't name a table
+		list = &ast.List{}
 	default:
 		return nil, fmt.Errorf("sourceTables: unsupported node type: %T", n)
 	}
@@ -244,8 +376,20 @@ func sourceTables(qc *QueryCatalog, node ast.Node) ([]*Table, error) {
 	var tables []*Table
 	for _, item := range list.Items {
 		switch n := item.(type) {
+		case *ast.RangeFunction:
+			// Functions in FROM can always refer to earlier FROM items
+			table, err := rangeFunctionTable(qc.withOuterScope(tables), n)
+			if err != nil {
+				return nil, err
+			}
+			tables = append(tables, table)
+
 		case *ast.RangeSubselect:
-			cols, err := outputColumns(qc, n.Subquery)
+			sqc := qc
+			if n.Lateral {
+				sqc = qc.withOuterScope(tables)
+			}
+			cols, err := outputColumns(sqc, n.Subquery)
 			if err != nil {
 				return nil, err
 			}
@@ -283,55 +427,117 @@ func sourceTables(qc *QueryCatalog, node ast.Node) ([]*Table, error) {
 	return tables, nil
 }
 
-func outputColumnRefs(res *ast.ResTarget, tables []*Table, node *ast.ColumnRef) ([]*Column, error) {
+// fromClauseItems flattens the joins in a FROM clause, returning the
+// referenced tables, subqueries and functions in the order they appear.
+func fromClauseItems(node ast.Node) []ast.Node {
+	var items []ast.Node
+	switch n := node.(type) {
+	case *ast.List:
+		if n == nil {
+			return nil
+		}
+		for _, item := range n.Items {
+			items = append(items, fromClauseItems(item)...)
+		}
+	case *ast.JoinExpr:
+		items = append(items, fromClauseItems(n.Larg)...)
+		items = append(items, fromClauseItems(n.Rarg)...)
+	case *ast.RangeFunction, *ast.RangeSubselect, *ast.RangeVar:
+		items = append(items, n)
+	}
+	return items
+}
+
+// otherItems returns the items of the FROM clause of an UPDATE or the USING
+// clause of a DELETE other than the table being modified. A MySQL UPDATE or
+// DELETE modifies a table through an outer join that keeps all of its rows,
+// which makes it an item of the join as well.
+func otherItems(node ast.Node, rel *ast.RangeVar) []ast.Node {
+	var items []ast.Node
+	for _, item := range fromClauseItems(node) {
+		if rv, ok := item.(*ast.RangeVar); !ok || rv != rel {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func outputColumnRefs(c *catalog.Catalog, res *ast.ResTarget, scopes [][]*Table, node *ast.ColumnRef) ([]*Column, error) {
 	parts := stringSlice(node.Fields)
-	var name, alias string
+	var name, alias, schema string
 	switch {
 	case len(parts) == 1:
 		name = parts[0]
 	case len(parts) == 2:
 		alias = parts[0]
 		name = parts[1]
+	case len(parts) == 3:
+		schema = parts[0]
+		alias = parts[1]
+		name = parts[2]
 	default:
 		return nil, fmt.Errorf("unknown number of fields: %d", len(parts))
 	}
-	var cols []*Column
-	var found int
-	for _, t := range tables {
-		if alias != "" && t.Rel.Name != alias {
-			continue
-		}
-		for _, c := range t.Columns {
-			if c.Name == name {
-				found += 1
-				cname := c.Name
-				if res.Name != nil {
-					cname = *res.Name
+	// Search the innermost scope first, only falling back to the outer
+	// scopes if the column can't be found
+	var aliasFound bool
+	for _, tables := range scopes {
+		var cols []*Column
+		var found int
+		for _, t := range tables {
+			if alias != "" && t.Rel.Name != alias {
+				continue
+			}
+			// Tell apart tables of the same name in different schemas,
+			// such as "app.users" and "archive.users"
+			if !sameSchema(c, t.Rel, schema) {
+				continue
+			}
+			aliasFound = true
+			for _, c := range t.Columns {
+				if c.Name == name {
+					found += 1
+					cname := c.Name
+					if res.Name != nil {
+						cname = *res.Name
+					}
+					cols = append(cols, &Column{
+						Name:     cname,
+						Type:     c.Type,
+						Table:    c.Table,
+						DataType: c.DataType,
+						NotNull:  c.NotNull,
+						IsArray:  c.IsArray,
+						Unsigned: c.Unsigned,
+						Length:   c.Length,
+					})
 				}
-				cols = append(cols, &Column{
-					Name:     cname,
-					Type:     c.Type,
-					Table:    c.Table,
-					DataType: c.DataType,
-					NotNull:  c.NotNull,
-					IsArray:  c.IsArray,
-				})
 			}
 		}
-	}
-	if found == 0 {
-		return nil, &sqlerr.Error{
-			Code:     "42703",
-			Message:  fmt.Sprintf("column \"%s\" does not exist", name),
-			Location: res.Location,
+		if found > 1 {
+			err := sqlerr.ColumnAmbiguous(name)
+			err.Location = res.Location
+			return nil, err
+		}
+		if found == 1 {
+			return cols, nil
 		}
 	}
-	if found > 1 {
-		return nil, &sqlerr.Error{
-			Code:     "42703",
-			Message:  fmt.Sprintf("column reference \"%s\" is ambiguous", name),
-			Location: res.Location,
+	if alias != "" {
+		if !aliasFound {
+			return nil, &sqlerr.Error{
+				Code:     "42P01",
+				Message:  fmt.Sprintf("missing FROM-clause entry for table \"%s\"", alias),
+				Location: res.Location,
+			}
 		}
+		err := sqlerr.ColumnNotFound(alias, name)
+		err.Location = res.Location
+		return nil, err
+	}
+	return nil, &sqlerr.Error{
+		Code:     "42703",
+		Message:  fmt.Sprintf("column \"%s\" does not exist", name),
+		Location: res.Location,
 	}
-	return cols, nil
 }
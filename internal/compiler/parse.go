@@ -6,6 +6,7 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/kyleconroy/sqlc/internal/config"
 	"github.com/kyleconroy/sqlc/internal/debug"
 	"github.com/kyleconroy/sqlc/internal/metadata"
 	"github.com/kyleconroy/sqlc/internal/opts"
@@ -44,6 +45,18 @@ func (c *Compiler) parseQuery(stmt ast.Node, src string, o opts.Parser) (*Query,
 	if !ok {
 		return nil, errors.New("node is not a statement")
 	}
+	rawSQL, err := source.Pluck(src, raw.StmtLocation, raw.StmtLen)
+	if err != nil {
+		return nil, err
+	}
+	var name, cmd string
+	if rawSQL != "" {
+		name, cmd, err = metadata.Parse(strings.TrimSpace(rawSQL), c.parser.CommentSyntax())
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	switch n := raw.Stmt.(type) {
 	case *ast.SelectStmt:
 	case *ast.DeleteStmt:
@@ -53,27 +66,39 @@ func (c *Compiler) parseQuery(stmt ast.Node, src string, o opts.Parser) (*Query,
 		}
 	case *ast.TruncateStmt:
 	case *ast.UpdateStmt:
+		if err := resolveUpdateTarget(c.catalog, n); err != nil {
+			return nil, err
+		}
 	default:
-		return nil, ErrUnsupportedStatementType
-	}
-
-	rawSQL, err := source.Pluck(src, raw.StmtLocation, raw.StmtLen)
-	if err != nil {
-		return nil, err
+		if !isUtilityStmt(n) {
+			// A file of queries can also hold the statements of the schema
+			// it's run against, which are skipped unless they're named
+			if name != "" {
+				return nil, fmt.Errorf("query %q: unsupported statement type: %s", name, strings.TrimPrefix(fmt.Sprintf("%T", n), "*ast."))
+			}
+			return nil, ErrUnsupportedStatementType
+		}
+		if name == "" {
+			return nil, ErrUnsupportedStatementType
+		}
+		if err := validateUtilityStmt(n); err != nil {
+			return nil, err
+		}
 	}
 	if rawSQL == "" {
 		return nil, errors.New("missing semicolon at end of file")
 	}
+
 	if err := validate.FuncCall(c.catalog, raw); err != nil {
 		return nil, err
 	}
-	name, cmd, err := metadata.Parse(strings.TrimSpace(rawSQL), c.parser.CommentSyntax())
-	if err != nil {
-		return nil, err
-	}
 	if err := validate.Cmd(raw.Stmt, name, cmd); err != nil {
 		return nil, err
 	}
+	// PostgreSQL drivers don't implement LastInsertId
+	if cmd == metadata.CmdExecLastId && c.conf.Engine == config.EnginePostgreSQL {
+		return nil, fmt.Errorf("query %q specifies parameter %q, which isn't supported by PostgreSQL; use a RETURNING id clause with \":one\" instead", name, cmd)
+	}
 
 	raw, namedParams, edits := rewrite.NamedParameters(c.conf.Engine, raw)
 	rvs := rangeVars(raw.Stmt)
@@ -83,6 +108,10 @@ func (c *Compiler) parseQuery(stmt ast.Node, src string, o opts.Parser) (*Query,
 		if err != nil {
 			return nil, err
 		}
+	} else if c.conf.Engine == config.EngineMySQL || c.conf.Engine == config.EngineMariaDB {
+		// MySQL placeholders are positional, so a named parameter is passed
+		// once for each placeholder it was replaced with
+		sort.SliceStable(refs, func(i, j int) bool { return refs[i].ref.Location < refs[j].ref.Location })
 	} else {
 		refs = uniqueParamRefs(refs)
 		sort.Slice(refs, func(i, j int) bool { return refs[i].ref.Number < refs[j].ref.Number })
@@ -100,6 +129,15 @@ func (c *Compiler) parseQuery(stmt ast.Node, src string, o opts.Parser) (*Query,
 	if err != nil {
 		return nil, err
 	}
+	if err := validateColumnRefs(qc, raw.Stmt); err != nil {
+		return nil, err
+	}
+	if err := validateAssignTypes(qc, raw.Stmt); err != nil {
+		return nil, err
+	}
+	if err := validateGeneratedColumns(qc, raw.Stmt); err != nil {
+		return nil, err
+	}
 
 	expandEdits, err := c.expand(qc, raw)
 	if err != nil {
@@ -140,6 +178,15 @@ func rangeVars(root ast.Node) []*ast.RangeVar {
 		switch n := node.(type) {
 		case *ast.RangeVar:
 			vars = append(vars, n)
+		case *ast.InsertStmt:
+			// The EXCLUDED row of an ON CONFLICT clause has the columns of
+			// the inserted table
+			if n.OnConflictClause != nil && n.Relation != nil {
+				name := "excluded"
+				excluded := *n.Relation
+				excluded.Alias = &ast.Alias{Aliasname: &name}
+				vars = append(vars, &excluded)
+			}
 		}
 	})
 	astutils.Walk(find, root)
@@ -14,6 +14,13 @@ type Column struct {
 	IsArray  bool
 	Comment  string
 
+	// MySQL integer columns can be unsigned, and have a display width
+	Unsigned bool
+	Length   *int
+
+	// Generated columns can only be assigned DEFAULT
+	Generated bool
+
 	// XXX: Figure out what PostgreSQL calls `foo.id`
 	Scope string
 	Table *ast.TableName
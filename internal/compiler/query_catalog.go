@@ -1,13 +1,20 @@
 package compiler
 
 import (
+	"fmt"
+
 	"github.com/kyleconroy/sqlc/internal/sql/ast"
 	"github.com/kyleconroy/sqlc/internal/sql/catalog"
+	"github.com/kyleconroy/sqlc/internal/sql/sqlerr"
 )
 
 type QueryCatalog struct {
 	catalog *catalog.Catalog
 	ctes    map[string]*Table
+
+	// Tables of the enclosing statements, innermost first. Column
+	// references that can't be found locally are resolved against these.
+	outer [][]*Table
 }
 
 func buildQueryCatalog(c *catalog.Catalog, node ast.Node) (*QueryCatalog, error) {
@@ -26,32 +33,140 @@ func buildQueryCatalog(c *catalog.Catalog, node ast.Node) (*QueryCatalog, error)
 	if with != nil {
 		for _, item := range with.Ctes.Items {
 			if cte, ok := item.(*ast.CommonTableExpr); ok {
-				cols, err := outputColumns(qc, cte.Ctequery)
-				if err != nil {
+				if err := qc.addCTE(cte, with.Recursive); err != nil {
 					return nil, err
 				}
-				rel := &ast.TableName{Name: *cte.Ctename}
-				for i := range cols {
-					cols[i].Table = rel
+			}
+		}
+	}
+	return qc, nil
+}
+
+func (qc *QueryCatalog) addCTE(cte *ast.CommonTableExpr, recursive bool) error {
+	rel := &ast.TableName{Name: *cte.Ctename}
+	table := func(cols []*Column) (*Table, error) {
+		if cte.Aliascolnames != nil && len(cte.Aliascolnames.Items) > 0 {
+			names := stringSlice(cte.Aliascolnames)
+			if len(names) > len(cols) {
+				return nil, &sqlerr.Error{
+					Code:     "42P10",
+					Message:  fmt.Sprintf("WITH query \"%s\" has %d columns available but %d columns specified", rel.Name, len(cols), len(names)),
+					Location: cte.Location,
 				}
-				qc.ctes[*cte.Ctename] = &Table{
-					Rel:     rel,
-					Columns: cols,
+			}
+			for i := range names {
+				cols[i].Name = names[i]
+			}
+		}
+		for i := range cols {
+			cols[i].Table = rel
+		}
+		return &Table{Rel: rel, Columns: cols}, nil
+	}
+
+	// A recursive query is typed by its non-recursive term, which makes the
+	// CTE visible to the recursive term. The recursive term must then
+	// produce columns that match.
+	//
+	// https://www.postgresql.org/docs/current/queries-with.html#QUERIES-WITH-RECURSIVE
+	if sel, ok := cte.Ctequery.(*ast.SelectStmt); ok && recursive && sel.Op == ast.Union {
+		cols, err := outputColumns(qc, sel.Larg)
+		if err != nil {
+			return err
+		}
+		t, err := table(cols)
+		if err != nil {
+			return err
+		}
+		qc.ctes[rel.Name] = t
+		recursiveCols, err := outputColumns(qc, sel.Rarg)
+		if err != nil {
+			return err
+		}
+		if len(recursiveCols) != len(cols) {
+			return &sqlerr.Error{
+				Code:     "42601",
+				Message:  "each UNION query must have the same number of columns",
+				Location: setOperationLocation(sel.Rarg, 0),
+			}
+		}
+		for i, col := range t.Columns {
+			rc := recursiveCols[i]
+			winner, ok := catalog.CommonType(col.DataType, rc.DataType)
+			switch {
+			case !ok || col.IsArray != rc.IsArray:
+				return setOperationTypeError(sel, col, rc, i)
+			case winner == 1 && catalog.TypeCategory(col.DataType) != 'X':
+				return &sqlerr.Error{
+					Code:     "42804",
+					Message:  fmt.Sprintf("recursive query \"%s\" column %d has type %s in non-recursive term but type %s overall", rel.Name, i+1, displayType(col), displayType(rc)),
+					Location: setOperationLocation(sel.Larg, i),
 				}
+			case winner == 1:
+				// The non-recursive term is untyped, e.g. a NULL literal
+				col.DataType = rc.DataType
+				col.Type = rc.Type
 			}
+			col.NotNull = col.NotNull && rc.NotNull
 		}
+		return nil
 	}
-	return qc, nil
+
+	cols, err := outputColumns(qc, cte.Ctequery)
+	if err != nil {
+		return err
+	}
+	t, err := table(cols)
+	if err != nil {
+		return err
+	}
+	qc.ctes[rel.Name] = t
+	return nil
+}
+
+// withOuterScope returns a copy of the query catalog that resolves otherwise
+// unknown column references against the given tables, e.g. for LATERAL
+// subqueries.
+func (qc *QueryCatalog) withOuterScope(tables []*Table) *QueryCatalog {
+	outer := append([][]*Table{tables}, qc.outer...)
+	return &QueryCatalog{catalog: qc.catalog, ctes: qc.ctes, outer: outer}
+}
+
+// withCTEs returns a copy of the query catalog that also contains the common
+// table expressions of a WITH clause.
+func (qc *QueryCatalog) withCTEs(with *ast.WithClause) (*QueryCatalog, error) {
+	ctes := map[string]*Table{}
+	for name, t := range qc.ctes {
+		ctes[name] = t
+	}
+	nqc := &QueryCatalog{catalog: qc.catalog, ctes: ctes, outer: qc.outer}
+	for _, item := range with.Ctes.Items {
+		if cte, ok := item.(*ast.CommonTableExpr); ok {
+			if err := nqc.addCTE(cte, with.Recursive); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return nqc, nil
+}
+
+// scopes returns the tables to search when resolving a column reference,
+// innermost scope first.
+func (qc *QueryCatalog) scopes(tables []*Table) [][]*Table {
+	return append([][]*Table{tables}, qc.outer...)
 }
 
 func ConvertColumn(rel *ast.TableName, c *catalog.Column) *Column {
 	return &Column{
-		Table:    rel,
-		Name:     c.Name,
-		DataType: dataType(&c.Type),
-		NotNull:  c.IsNotNull,
-		IsArray:  c.IsArray,
-		Type:     &c.Type,
+		Table:     rel,
+		Name:      c.Name,
+		DataType:  dataType(&c.Type),
+		NotNull:   c.IsNotNull,
+		IsArray:   c.IsArray,
+		Unsigned:  c.Unsigned,
+		Length:    c.Length,
+		Generated: c.Generated,
+		Type:      &c.Type,
 	}
 }
 
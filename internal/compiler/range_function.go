@@ -0,0 +1,212 @@
+package compiler
+
+import (
+	"fmt"
+
+	"github.com/kyleconroy/sqlc/internal/sql/ast"
+	"github.com/kyleconroy/sqlc/internal/sql/catalog"
+	"github.com/kyleconroy/sqlc/internal/sql/sqlerr"
+)
+
+// Compute the columns of a function call in a FROM clause, such as
+// `generate_series(1, 10)` or `json_to_recordset($1) AS x(a int, b text)`.
+//
+// https://www.postgresql.org/docs/current/queries-table-expressions.html#QUERIES-TABLEFUNCTIONS
+func rangeFunctionTable(qc *QueryCatalog, n *ast.RangeFunction) (*Table, error) {
+	var name string
+	var cols []*Column
+	var scalar bool
+	for _, item := range n.Functions.Items {
+		call, coldefs := rangeFunctionCall(item)
+		if call == nil {
+			return nil, fmt.Errorf("rangeFunctionTable: unsupported function item: %T", item)
+		}
+		if name == "" {
+			name = call.Func.Name
+		}
+		// The column definition list of a single function is stored on the
+		// RangeFunction itself, while ROWS FROM keeps one list per function
+		if coldefs == nil && len(n.Functions.Items) == 1 && n.Coldeflist != nil {
+			coldefs = n.Coldeflist
+		}
+		fcols, ok, err := rangeFunctionColumns(qc, call, coldefs)
+		if err != nil {
+			return nil, err
+		}
+		scalar = ok && len(n.Functions.Items) == 1
+		cols = append(cols, fcols...)
+	}
+	if n.Ordinality {
+		cols = append(cols, &Column{
+			Name:     "ordinality",
+			DataType: "bigint",
+			NotNull:  true,
+		})
+	}
+
+	if n.Alias != nil && n.Alias.Aliasname != nil {
+		name = *n.Alias.Aliasname
+		// The column of a function returning a base type takes the name of
+		// the alias
+		if scalar {
+			cols[0].Name = name
+		}
+		if n.Alias.Colnames != nil {
+			if len(n.Alias.Colnames.Items) > len(cols) {
+				return nil, &sqlerr.Error{
+					Code:    "42P10",
+					Message: fmt.Sprintf("table \"%s\" has %d columns available but %d columns specified", name, len(cols), len(n.Alias.Colnames.Items)),
+				}
+			}
+			for i, colname := range stringSlice(n.Alias.Colnames) {
+				cols[i].Name = colname
+			}
+		}
+	}
+
+	return &Table{
+		Rel:     &ast.TableName{Name: name},
+		Columns: cols,
+	}, nil
+}
+
+func rangeFunctionCall(node ast.Node) (*ast.FuncCall, *ast.List) {
+	switch n := node.(type) {
+	case *ast.FuncCall:
+		return n, nil
+	case *ast.List:
+		if len(n.Items) == 0 {
+			return nil, nil
+		}
+		call, ok := n.Items[0].(*ast.FuncCall)
+		if !ok {
+			return nil, nil
+		}
+		var coldefs *ast.List
+		if len(n.Items) > 1 {
+			if l, ok := n.Items[1].(*ast.List); ok && len(l.Items) > 0 {
+				coldefs = l
+			}
+		}
+		return call, coldefs
+	}
+	return nil, nil
+}
+
+// rangeFunctionColumns returns the columns produced by a single function call
+// and whether the function returns a base type rather than a row.
+func rangeFunctionColumns(qc *QueryCatalog, call *ast.FuncCall, coldefs *ast.List) ([]*Column, bool, error) {
+	if coldefs != nil && len(coldefs.Items) > 0 {
+		var cols []*Column
+		for _, item := range coldefs.Items {
+			def, ok := item.(*ast.ColumnDef)
+			if !ok {
+				continue
+			}
+			col := toColumn(def.TypeName)
+			col.Name = def.Colname
+			col.NotNull = def.IsNotNull
+			cols = append(cols, col)
+		}
+		return cols, false, nil
+	}
+
+	fun, err := resolveFuncCall(qc, call)
+	if err != nil {
+		// Don't fail on functions missing from the catalog (e.g. defined in an
+		// extension); their result just isn't typed
+		return []*Column{{Name: call.Func.Name, DataType: "any"}}, true, nil
+	}
+
+	// Functions declared with OUT or TABLE parameters return a row
+	var cols []*Column
+	for _, arg := range fun.Args {
+		switch arg.Mode {
+		case ast.FuncParamOut, ast.FuncParamInOut, ast.FuncParamTable:
+			col := toColumn(arg.Type)
+			col.Name = arg.Name
+			col.NotNull = false
+			cols = append(cols, col)
+		}
+	}
+	if len(cols) > 0 {
+		return cols, false, nil
+	}
+
+	if fun.ReturnType == nil {
+		return []*Column{{Name: call.Func.Name, DataType: "any"}}, true, nil
+	}
+
+	// Functions returning a composite row, e.g. `RETURNS SETOF authors`
+	rt := fun.ReturnType
+	if rt.Name != "" {
+		rel := &ast.TableName{Catalog: rt.Catalog, Schema: rt.Schema, Name: rt.Name}
+		if table, err := qc.GetTable(rel); err == nil {
+			for _, c := range table.Columns {
+				col := *c
+				cols = append(cols, &col)
+			}
+			return cols, false, nil
+		}
+	}
+
+	if dataType(fun.ReturnType) == "record" {
+		return nil, false, &sqlerr.Error{
+			Code:     "42601",
+			Message:  "a column definition list is required for functions returning \"record\"",
+			Location: call.Location,
+		}
+	}
+
+	col := polymorphicReturn(qc, fun, call)
+	col.Name = call.Func.Name
+	return []*Column{col}, true, nil
+}
+
+// polymorphicReturn computes the result column of a function, resolving
+// polymorphic return types such as anyelement from the types of the
+// arguments that were passed.
+//
+// https://www.postgresql.org/docs/current/extend-type-system.html#EXTEND-TYPES-POLYMORPHIC
+func polymorphicReturn(qc *QueryCatalog, fun *catalog.Function, call *ast.FuncCall) *Column {
+	rt := dataType(fun.ReturnType)
+	switch rt {
+	case "anyelement", "anyarray", "anynonarray", "anyenum":
+	default:
+		return &Column{
+			DataType: rt,
+			NotNull:  true,
+			IsArray:  isArray(fun.ReturnType),
+		}
+	}
+
+	args := fun.InArgs()
+	for i, item := range call.Args.Items {
+		if i >= len(args) {
+			break
+		}
+		declared := dataType(args[i].Type)
+		switch declared {
+		case "anyelement", "anyarray", "anynonarray", "anyenum":
+		default:
+			continue
+		}
+		actual := exprColumn(qc, item)
+		if actual == nil {
+			continue
+		}
+		col := *actual
+		col.Name = ""
+		col.NotNull = true
+		// The element type of an anyarray argument is the element type of
+		// the array that was passed
+		switch {
+		case declared == "anyarray" && rt != "anyarray":
+			col.IsArray = false
+		case declared != "anyarray" && rt == "anyarray":
+			col.IsArray = true
+		}
+		return &col
+	}
+	return &Column{DataType: "any"}
+}
@@ -39,7 +39,17 @@ func resolveCatalogRefs(c *catalog.Catalog, rvs []*ast.RangeVar, args []paramRef
 		if err != nil {
 			return nil, err
 		}
-		tables = append(tables, fqn)
+		// A table referenced more than once, e.g. in a self-join, only needs
+		// to be searched once
+		var seen bool
+		for _, t := range tables {
+			if t.Catalog == fqn.Catalog && t.Schema == fqn.Schema && t.Name == fqn.Name {
+				seen = true
+			}
+		}
+		if !seen {
+			tables = append(tables, fqn)
+		}
 		if defaultTable == nil {
 			defaultTable = fqn
 		}
@@ -79,6 +89,25 @@ func resolveCatalogRefs(c *catalog.Catalog, rvs []*ast.RangeVar, args []paramRef
 				},
 			})
 
+		case *frameOffset:
+			a = append(a, Parameter{
+				Number: ref.ref.Number,
+				Column: &Column{
+					Name:     parameterName(ref.ref.Number, n.name),
+					DataType: "bigint",
+					NotNull:  true,
+				},
+			})
+
+		case *variableValue:
+			a = append(a, Parameter{
+				Number: ref.ref.Number,
+				Column: &Column{
+					Name:     parameterName(ref.ref.Number, n.name),
+					DataType: "any",
+				},
+			})
+
 		case *limitCount:
 			a = append(a, Parameter{
 				Number: ref.ref.Number,
@@ -116,24 +145,28 @@ func resolveCatalogRefs(c *catalog.Catalog, rvs []*ast.RangeVar, args []paramRef
 			switch left := list.Items[0].(type) {
 			case *ast.ColumnRef:
 				items := stringSlice(left.Fields)
-				var key, alias string
+				var key, alias, schema string
 				switch len(items) {
 				case 1:
 					key = items[0]
 				case 2:
 					alias = items[0]
 					key = items[1]
+				case 3:
+					schema = items[0]
+					alias = items[1]
+					key = items[2]
 				default:
 					panic("too many field items: " + strconv.Itoa(len(items)))
 				}
 
 				search := tables
 				if alias != "" {
-					if original, ok := aliasMap[alias]; ok {
+					if original, ok := aliasMap[alias]; ok && schema == "" {
 						search = []*ast.TableName{original}
 					} else {
 						for _, fqn := range tables {
-							if fqn.Name == alias {
+							if fqn.Name == alias && sameSchema(c, fqn, schema) {
 								search = []*ast.TableName{fqn}
 							}
 						}
@@ -142,20 +175,36 @@ func resolveCatalogRefs(c *catalog.Catalog, rvs []*ast.RangeVar, args []paramRef
 
 				var found int
 				for _, table := range search {
-					if c, ok := typeMap[table.Schema][table.Name][key]; ok {
+					if tc, ok := typeMap[table.Schema][table.Name][key]; ok {
 						found += 1
+						name := key
+						col := &Column{
+							DataType: dataType(&tc.Type),
+							NotNull:  tc.IsNotNull,
+							IsArray:  tc.IsArray,
+							Unsigned: tc.Unsigned,
+							Length:   tc.Length,
+							Table:    table,
+						}
+						// Compared to a field of a composite column, such as
+						// `(address).city = $1`
+						if ind, ok := n.Lexpr.(*ast.A_Indirection); ok {
+							if arg, items := unwrapIndirection(ind); arg == left {
+								if fc := applyIndirection(c, col, items); fc != nil {
+									col = fc
+									if fc.Name != "" {
+										name = fc.Name
+									}
+								}
+							}
+						}
 						if ref.name != "" {
-							key = ref.name
+							name = ref.name
 						}
+						col.Name = parameterName(ref.ref.Number, name)
 						a = append(a, Parameter{
 							Number: ref.ref.Number,
-							Column: &Column{
-								Name:     parameterName(ref.ref.Number, key),
-								DataType: dataType(&c.Type),
-								NotNull:  c.IsNotNull,
-								IsArray:  c.IsArray,
-								Table:    table,
-							},
+							Column: col,
 						})
 					}
 				}
@@ -168,11 +217,9 @@ func resolveCatalogRefs(c *catalog.Catalog, rvs []*ast.RangeVar, args []paramRef
 					}
 				}
 				if found > 1 {
-					return nil, &sqlerr.Error{
-						Code:     "42703",
-						Message:  fmt.Sprintf("column reference \"%s\" is ambiguous", key),
-						Location: left.Location,
-					}
+					err := sqlerr.ColumnAmbiguous(key)
+					err.Location = left.Location
+					return nil, err
 				}
 			}
 
@@ -242,8 +289,12 @@ func resolveCatalogRefs(c *catalog.Catalog, rvs []*ast.RangeVar, args []paramRef
 				var paramName string
 				var paramType *ast.TypeName
 				if argName == "" {
-					paramName = fun.Args[i].Name
-					paramType = fun.Args[i].Type
+					if arg := fun.CallArg(i); arg != nil {
+						paramName = arg.Name
+						paramType = arg.Type
+					} else {
+						paramType = &ast.TypeName{Name: "any"}
+					}
 				} else {
 					paramName = argName
 					for _, arg := range fun.Args {
@@ -294,6 +345,8 @@ func resolveCatalogRefs(c *catalog.Catalog, rvs []*ast.RangeVar, args []paramRef
 						DataType: dataType(&c.Type),
 						NotNull:  c.IsNotNull,
 						IsArray:  c.IsArray,
+						Unsigned: c.Unsigned,
+						Length:   c.Length,
 						Table:    &ast.TableName{Schema: schema, Name: rel},
 					},
 				})
@@ -305,6 +358,40 @@ func resolveCatalogRefs(c *catalog.Catalog, rvs []*ast.RangeVar, args []paramRef
 				}
 			}
 
+		case *insertPosition:
+			fqn := defaultTable
+			if ref.rv != nil {
+				var err error
+				fqn, err = ParseTableName(ref.rv)
+				if err != nil {
+					return nil, err
+				}
+			}
+			table, err := c.GetTable(fqn)
+			if err != nil {
+				return nil, err
+			}
+			if n.index >= len(table.Columns) {
+				return nil, &sqlerr.Error{
+					Code:     "42601",
+					Message:  "INSERT has more expressions than target columns",
+					Location: ref.ref.Location,
+				}
+			}
+			col := table.Columns[n.index]
+			a = append(a, Parameter{
+				Number: ref.ref.Number,
+				Column: &Column{
+					Name:     parameterName(ref.ref.Number, col.Name),
+					DataType: dataType(&col.Type),
+					NotNull:  col.IsNotNull,
+					IsArray:  col.IsArray,
+					Unsigned: col.Unsigned,
+					Length:   col.Length,
+					Table:    &ast.TableName{Schema: fqn.Schema, Name: fqn.Name},
+				},
+			})
+
 		case *ast.TypeCast:
 			if n.TypeName == nil {
 				return nil, fmt.Errorf("*ast.TypeCast has nil type name")
@@ -325,3 +412,13 @@ func resolveCatalogRefs(c *catalog.Catalog, rvs []*ast.RangeVar, args []paramRef
 	}
 	return a, nil
 }
+
+// sameSchema reports whether a table referenced in a query is in the given
+// schema, where an unqualified table is in the default schema. An empty
+// schema matches any table.
+func sameSchema(c *catalog.Catalog, fqn *ast.TableName, schema string) bool {
+	if schema == "" || fqn.Schema == schema {
+		return true
+	}
+	return fqn.Schema == "" && schema == c.DefaultSchema
+}
@@ -0,0 +1,12 @@
+package compiler
+
+import "strings"
+
+// displayType formats the type of a column for use in error messages.
+func displayType(c *Column) string {
+	name := strings.TrimPrefix(c.DataType, "pg_catalog.")
+	if c.IsArray {
+		return name + "[]"
+	}
+	return name
+}
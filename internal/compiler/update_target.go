@@ -0,0 +1,99 @@
+package compiler
+
+import (
+	"fmt"
+
+	"github.com/kyleconroy/sqlc/internal/sql/ast"
+	"github.com/kyleconroy/sqlc/internal/sql/catalog"
+	"github.com/kyleconroy/sqlc/internal/sql/sqlerr"
+)
+
+// resolveUpdateTarget picks the table assigned to by an UPDATE of several
+// tables that doesn't name it, such as MySQL's
+// UPDATE books JOIN authors ON ... SET bio = ?. Its tables are all in the
+// FROM clause, and the one with every assigned column is moved out of it,
+// unless it's part of an outer join, which is kept as it is.
+func resolveUpdateTarget(c *catalog.Catalog, n *ast.UpdateStmt) error {
+	if n.Relation != nil || n.FromClause == nil {
+		return nil
+	}
+	var names []string
+	for _, item := range n.TargetList.Items {
+		if res, ok := item.(*ast.ResTarget); ok && res.Name != nil {
+			names = append(names, *res.Name)
+		}
+	}
+
+	var target *ast.RangeVar
+	var tables []catalog.Table
+	for _, item := range fromClauseItems(n.FromClause) {
+		rv, ok := item.(*ast.RangeVar)
+		if !ok {
+			continue
+		}
+		table, err := rangeVarTable(c, rv)
+		if err != nil {
+			return err
+		}
+		tables = append(tables, table)
+		if !hasColumns(table, names) {
+			continue
+		}
+		if target != nil {
+			return sqlerr.ColumnAmbiguous(names[0])
+		}
+		target = rv
+	}
+	if target != nil {
+		n.Relation = target
+		for i, item := range n.FromClause.Items {
+			if item == ast.Node(target) {
+				n.FromClause.Items = append(n.FromClause.Items[:i:i], n.FromClause.Items[i+1:]...)
+				break
+			}
+		}
+		return nil
+	}
+
+	// Report the columns no table has before those of several tables
+	for _, name := range names {
+		var found bool
+		for _, table := range tables {
+			found = found || hasColumns(table, []string{name})
+		}
+		if !found {
+			return &sqlerr.Error{
+				Err:     sqlerr.NotFound,
+				Code:    "42703",
+				Message: fmt.Sprintf("column \"%s\"", name),
+			}
+		}
+	}
+	return &sqlerr.Error{
+		Message: "an UPDATE that assigns to columns of more than one table is not supported",
+	}
+}
+
+func rangeVarTable(c *catalog.Catalog, rv *ast.RangeVar) (catalog.Table, error) {
+	fqn, err := ParseTableName(rv)
+	if err != nil {
+		return catalog.Table{}, err
+	}
+	return c.GetTable(fqn)
+}
+
+func hasColumns(table catalog.Table, names []string) bool {
+	for _, name := range names {
+		var found bool
+		for _, col := range table.Columns {
+			if col.Name == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,47 @@
+package compiler
+
+import (
+	"fmt"
+
+	"github.com/kyleconroy/sqlc/internal/sql/ast"
+	"github.com/kyleconroy/sqlc/internal/sql/astutils"
+	"github.com/kyleconroy/sqlc/internal/sql/sqlerr"
+)
+
+// isUtilityStmt reports whether a statement can be run as an :exec query
+// that passes through to the database without being analyzed.
+func isUtilityStmt(node ast.Node) bool {
+	switch node.(type) {
+	case *ast.CreateTableStmt:
+	case *ast.LockStmt:
+	case *ast.NotifyStmt:
+	case *ast.RefreshMatViewStmt:
+	case *ast.VacuumStmt:
+	case *ast.VariableSetStmt:
+	default:
+		return false
+	}
+	return true
+}
+
+// validateUtilityStmt checks that a utility statement only uses parameters
+// where they're allowed. Only the values of a MySQL SET statement can be
+// parameters; PostgreSQL doesn't accept parameters in utility statements.
+func validateUtilityStmt(node ast.Node) error {
+	if _, ok := node.(*ast.VariableSetStmt); ok {
+		return nil
+	}
+	refs := astutils.Search(node, func(node ast.Node) bool {
+		_, ok := node.(*ast.ParamRef)
+		return ok
+	})
+	if len(refs.Items) == 0 {
+		return nil
+	}
+	ref := refs.Items[0].(*ast.ParamRef)
+	return &sqlerr.Error{
+		Code:     "42P02",
+		Message:  fmt.Sprintf("there is no parameter $%d", ref.Number),
+		Location: ref.Location,
+	}
+}
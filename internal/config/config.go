@@ -69,6 +69,7 @@ func (p *Paths) UnmarshalYAML(unmarshal func(interface{}) error) error {
 }
 
 const (
+	EngineMariaDB    Engine = "mariadb"
 	EngineMySQL      Engine = "mysql"
 	EnginePostgreSQL Engine = "postgresql"
 
@@ -140,6 +141,8 @@ type Override struct {
 
 	// True if the GoType should override if the maching postgres type is nullable
 	Nullable bool `json:"nullable" yaml:"nullable"`
+	// True if the GoType should only override unsigned MySQL columns
+	Unsigned bool `json:"unsigned" yaml:"unsigned"`
 	// Deprecated. Use the `nullable` property instead
 	Deprecated_Null bool `json:"null" yaml:"null"`
 
@@ -185,7 +188,7 @@ func (o *Override) Parse() error {
 		switch len(colParts) {
 		case 2:
 			o.ColumnName = colParts[1]
-			o.Table = core.FQN{Schema: "public", Rel: colParts[0]}
+			o.Table = core.FQN{Rel: colParts[0]}
 		case 3:
 			o.ColumnName = colParts[2]
 			o.Table = core.FQN{Schema: colParts[0], Rel: colParts[1]}
@@ -12,7 +12,7 @@ DELETE FROM bar b
 WHERE b.id = ?
 `
 
-func (q *Queries) AliasBar(ctx context.Context, id int64) error {
+func (q *Queries) AliasBar(ctx context.Context, id uint64) error {
 	_, err := q.db.ExecContext(ctx, aliasBar, id)
 	return err
 }
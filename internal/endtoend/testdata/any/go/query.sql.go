@@ -12,7 +12,7 @@ import (
 const any = `-- name: Any :many
 SELECT id
 FROM bar
-WHERE foo = ANY($1::bigserial[])
+WHERE id = ANY($1::bigserial[])
 `
 
 func (q *Queries) Any(ctx context.Context, dollar_1 []int64) ([]int64, error) {
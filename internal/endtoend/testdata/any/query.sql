@@ -3,4 +3,4 @@ CREATE TABLE bar (id bigserial not null);
 -- name: Any :many
 SELECT id
 FROM bar
-WHERE foo = ANY($1::bigserial[]);
+WHERE id = ANY($1::bigserial[]);
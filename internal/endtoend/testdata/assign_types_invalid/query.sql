@@ -0,0 +1,37 @@
+CREATE TABLE events (
+    id bigserial PRIMARY KEY,
+    name text NOT NULL,
+    attendees integer NOT NULL,
+    public boolean NOT NULL,
+    starts_at timestamp NOT NULL,
+    tags text[] NOT NULL
+);
+
+-- name: InsertStringIntoInteger :exec
+INSERT INTO events (name, attendees) VALUES ('party', 'abc');
+
+-- name: InsertBooleanIntoTimestamp :exec
+INSERT INTO events (name, starts_at) VALUES ('party', 1 > 2);
+
+-- name: InsertCast :exec
+INSERT INTO events (name, public) VALUES ('party', now()::date);
+
+-- name: InsertSelect :exec
+INSERT INTO events (name, attendees, public)
+SELECT name, public, public FROM events;
+
+-- name: InsertPositional :exec
+INSERT INTO events VALUES (1, 'party', 10, 'maybe');
+
+-- name: UpdateSet :exec
+UPDATE events SET attendees = starts_at WHERE id = $1;
+
+-- name: UpdateArray :exec
+UPDATE events SET tags = name WHERE id = $1;
+
+-- name: Valid :exec
+INSERT INTO events (name, attendees, public, starts_at, tags)
+VALUES (42, '10', 'yes', now() + interval '1 day', ARRAY['a']);
+
+-- name: ValidUpdate :exec
+UPDATE events SET attendees = attendees + 1, name = starts_at, public = NOT public WHERE id = $1;
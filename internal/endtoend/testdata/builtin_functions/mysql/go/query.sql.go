@@ -0,0 +1,260 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+)
+
+const listDates = `-- name: ListDates :many
+SELECT DATE_ADD(created, INTERVAL ? DAY) AS expires, DATE_SUB(born, INTERVAL 1 MONTH) AS born_before,
+  TIMESTAMPDIFF(DAY, created, NOW()) AS days, EXTRACT(YEAR FROM created) AS year, FROM_UNIXTIME(?) AS since
+FROM users
+`
+
+type ListDatesParams struct {
+	DATEADD      interface{}
+	FROMUNIXTIME int64
+}
+
+type ListDatesRow struct {
+	Expires    sql.NullTime
+	BornBefore sql.NullTime
+	Days       sql.NullInt64
+	Year       sql.NullInt64
+	Since      sql.NullTime
+}
+
+func (q *Queries) ListDates(ctx context.Context, arg ListDatesParams) ([]ListDatesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listDates, arg.DATEADD, arg.FROMUNIXTIME)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListDatesRow
+	for rows.Next() {
+		var i ListDatesRow
+		if err := rows.Scan(
+			&i.Expires,
+			&i.BornBefore,
+			&i.Days,
+			&i.Year,
+			&i.Since,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listFallbacks = `-- name: ListFallbacks :many
+SELECT IFNULL(nick, name) AS display, COALESCE(nick, name) AS handle, IF(age > ?, nick, NULL) AS adult_nick,
+  NULLIF(age, 0) AS known_age, GREATEST(score, ?) AS floor_score, LEAST(age, 99) AS capped_age
+FROM users
+`
+
+type ListFallbacksParams struct {
+	Age      sql.NullInt32
+	GREATEST interface{}
+}
+
+type ListFallbacksRow struct {
+	Display    string
+	Handle     string
+	AdultNick  sql.NullString
+	KnownAge   sql.NullInt32
+	FloorScore string
+	CappedAge  sql.NullInt32
+}
+
+func (q *Queries) ListFallbacks(ctx context.Context, arg ListFallbacksParams) ([]ListFallbacksRow, error) {
+	rows, err := q.db.QueryContext(ctx, listFallbacks, arg.Age, arg.GREATEST)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListFallbacksRow
+	for rows.Next() {
+		var i ListFallbacksRow
+		if err := rows.Scan(
+			&i.Display,
+			&i.Handle,
+			&i.AdultNick,
+			&i.KnownAge,
+			&i.FloorScore,
+			&i.CappedAge,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listFormatted = `-- name: ListFormatted :many
+SELECT DATE_FORMAT(created, '%Y-%m-%d') AS day, CONCAT_WS(', ', name, nick) AS label,
+  JSON_EXTRACT(data, '$.tags') AS tags, JSON_UNQUOTE(JSON_EXTRACT(data, '$.title')) AS title
+FROM users
+`
+
+type ListFormattedRow struct {
+	Day   sql.NullString
+	Label string
+	Tags  json.RawMessage
+	Title sql.NullString
+}
+
+func (q *Queries) ListFormatted(ctx context.Context) ([]ListFormattedRow, error) {
+	rows, err := q.db.QueryContext(ctx, listFormatted)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListFormattedRow
+	for rows.Next() {
+		var i ListFormattedRow
+		if err := rows.Scan(
+			&i.Day,
+			&i.Label,
+			&i.Tags,
+			&i.Title,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listNumbers = `-- name: ListNumbers :many
+SELECT CEIL(score) AS score_ceil, FLOOR(ratio) AS ratio_floor, ROUND(ratio, 2) AS ratio_rounded, CONCAT(name, ?, ?) AS tagged
+FROM users
+`
+
+type ListNumbersParams struct {
+	CONCAT   interface{}
+	CONCAT_2 interface{}
+}
+
+type ListNumbersRow struct {
+	ScoreCeil    string
+	RatioFloor   sql.NullFloat64
+	RatioRounded sql.NullFloat64
+	Tagged       string
+}
+
+func (q *Queries) ListNumbers(ctx context.Context, arg ListNumbersParams) ([]ListNumbersRow, error) {
+	rows, err := q.db.QueryContext(ctx, listNumbers, arg.CONCAT, arg.CONCAT_2)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListNumbersRow
+	for rows.Next() {
+		var i ListNumbersRow
+		if err := rows.Scan(
+			&i.ScoreCeil,
+			&i.RatioFloor,
+			&i.RatioRounded,
+			&i.Tagged,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listParsed = `-- name: ListParsed :many
+SELECT STR_TO_DATE(name, '%Y-%m-%d') AS parsed, JSON_UNQUOTE(JSON_EXTRACT(JSON_OBJECT('name', name), '$.nick')) AS extracted,
+  UPPER(name) AS shout
+FROM users
+`
+
+type ListParsedRow struct {
+	Parsed    sql.NullTime
+	Extracted sql.NullString
+	Shout     string
+}
+
+func (q *Queries) ListParsed(ctx context.Context) ([]ListParsedRow, error) {
+	rows, err := q.db.QueryContext(ctx, listParsed)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListParsedRow
+	for rows.Next() {
+		var i ListParsedRow
+		if err := rows.Scan(&i.Parsed, &i.Extracted, &i.Shout); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const stats = `-- name: Stats :one
+SELECT SUM(score) AS total, AVG(ratio) AS average, MAX(created) AS latest, MIN(name) AS first_name,
+  GROUP_CONCAT(name SEPARATOR ';') AS names, STDDEV(age) AS spread, COUNT(nick) AS nicks
+FROM users
+`
+
+type StatsRow struct {
+	Total     sql.NullString
+	Average   sql.NullFloat64
+	Latest    sql.NullTime
+	FirstName sql.NullString
+	Names     sql.NullString
+	Spread    sql.NullFloat64
+	Nicks     int64
+}
+
+func (q *Queries) Stats(ctx context.Context) (StatsRow, error) {
+	row := q.db.QueryRowContext(ctx, stats)
+	var i StatsRow
+	err := row.Scan(
+		&i.Total,
+		&i.Average,
+		&i.Latest,
+		&i.FirstName,
+		&i.Names,
+		&i.Spread,
+		&i.Nicks,
+	)
+	return i, err
+}
@@ -0,0 +1,28 @@
+-- name: ListFormatted :many
+SELECT DATE_FORMAT(created, '%Y-%m-%d') AS day, CONCAT_WS(', ', name, nick) AS label,
+  JSON_EXTRACT(data, '$.tags') AS tags, JSON_UNQUOTE(JSON_EXTRACT(data, '$.title')) AS title
+FROM users;
+
+-- name: ListFallbacks :many
+SELECT IFNULL(nick, name) AS display, COALESCE(nick, name) AS handle, IF(age > ?, nick, NULL) AS adult_nick,
+  NULLIF(age, 0) AS known_age, GREATEST(score, ?) AS floor_score, LEAST(age, 99) AS capped_age
+FROM users;
+
+-- name: ListDates :many
+SELECT DATE_ADD(created, INTERVAL ? DAY) AS expires, DATE_SUB(born, INTERVAL 1 MONTH) AS born_before,
+  TIMESTAMPDIFF(DAY, created, NOW()) AS days, EXTRACT(YEAR FROM created) AS year, FROM_UNIXTIME(?) AS since
+FROM users;
+
+-- name: ListNumbers :many
+SELECT CEIL(score) AS score_ceil, FLOOR(ratio) AS ratio_floor, ROUND(ratio, 2) AS ratio_rounded, CONCAT(name, ?, ?) AS tagged
+FROM users;
+
+-- name: Stats :one
+SELECT SUM(score) AS total, AVG(ratio) AS average, MAX(created) AS latest, MIN(name) AS first_name,
+  GROUP_CONCAT(name SEPARATOR ';') AS names, STDDEV(age) AS spread, COUNT(nick) AS nicks
+FROM users;
+
+-- name: ListParsed :many
+SELECT STR_TO_DATE(name, '%Y-%m-%d') AS parsed, JSON_UNQUOTE(JSON_EXTRACT(JSON_OBJECT('name', name), '$.nick')) AS extracted,
+  UPPER(name) AS shout
+FROM users;
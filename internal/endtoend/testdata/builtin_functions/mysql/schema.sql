@@ -0,0 +1,11 @@
+CREATE TABLE users (
+    id      BIGINT PRIMARY KEY AUTO_INCREMENT,
+    name    VARCHAR(255) NOT NULL,
+    nick    VARCHAR(255),
+    age     INT,
+    score   DECIMAL(10, 2) NOT NULL,
+    ratio   DOUBLE,
+    data    JSON,
+    created DATETIME NOT NULL,
+    born    DATE
+);
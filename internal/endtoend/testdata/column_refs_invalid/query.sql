@@ -0,0 +1,38 @@
+CREATE TABLE authors (id bigint PRIMARY KEY, name text NOT NULL);
+CREATE TABLE books (id bigint PRIMARY KEY, author_id bigint NOT NULL, title text NOT NULL);
+
+-- name: BadWhere :many
+SELECT id FROM authors WHERE nme = $1;
+
+-- name: BadJoinAlias :many
+SELECT b.title FROM books b JOIN authors a ON a.id = x.author_id;
+
+-- name: BadJoinColumn :many
+SELECT b.title FROM books b JOIN authors a ON a.id = b.writer_id;
+
+-- name: BadGroupBy :many
+SELECT count(*) FROM books GROUP BY writer;
+
+-- name: BadOrderBy :many
+SELECT id FROM books ORDER BY created_at;
+
+-- name: BadHaving :many
+SELECT author_id FROM books GROUP BY author_id HAVING count(pages) > 1;
+
+-- name: BadCorrelated :many
+SELECT id FROM authors a WHERE EXISTS (SELECT 1 FROM books b WHERE b.author_id = a.ident);
+
+-- name: Ambiguous :many
+SELECT title FROM books JOIN authors ON author_id = authors.id WHERE id = $1;
+
+-- name: BadUpdate :exec
+UPDATE books SET pages = $1 WHERE id = $2;
+
+-- name: Valid :many
+SELECT b.author_id AS writer, count(*) AS total
+FROM books b
+JOIN authors a USING (id)
+WHERE EXISTS (SELECT 1 FROM books WHERE books.author_id = a.id)
+GROUP BY writer
+HAVING count(*) > 1
+ORDER BY total;
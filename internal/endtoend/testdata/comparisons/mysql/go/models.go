@@ -5,5 +5,5 @@ package querytest
 import ()
 
 type Bar struct {
-	ID int64
+	ID uint64
 }
@@ -4,9 +4,275 @@ package querytest
 
 import (
 	"database/sql"
+	"database/sql/driver"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
 )
 
+type FooPointType struct {
+	X sql.NullInt32
+	Y sql.NullInt32
+}
+
+func (c *FooPointType) Scan(src interface{}) error {
+	var literal string
+	switch s := src.(type) {
+	case []byte:
+		literal = string(s)
+	case string:
+		literal = s
+	case nil:
+		*c = FooPointType{}
+		return nil
+	default:
+		return fmt.Errorf("unsupported scan type for FooPointType: %T", src)
+	}
+	fields, err := parseCompositeLiteral(literal)
+	if err != nil {
+		return err
+	}
+	if len(fields) != 2 {
+		return fmt.Errorf("FooPointType: expected 2 fields, got %d", len(fields))
+	}
+	*c = FooPointType{}
+	if err := scanTextField(&c.X, fields[0]); err != nil {
+		return fmt.Errorf("X: %w", err)
+	}
+	if err := scanTextField(&c.Y, fields[1]); err != nil {
+		return fmt.Errorf("Y: %w", err)
+	}
+	return nil
+}
+
+func (c FooPointType) Value() (driver.Value, error) {
+	return compositeLiteral(c.X, c.Y)
+}
+
+type PointType struct {
+	X sql.NullInt32
+	Y sql.NullInt32
+}
+
+func (c *PointType) Scan(src interface{}) error {
+	var literal string
+	switch s := src.(type) {
+	case []byte:
+		literal = string(s)
+	case string:
+		literal = s
+	case nil:
+		*c = PointType{}
+		return nil
+	default:
+		return fmt.Errorf("unsupported scan type for PointType: %T", src)
+	}
+	fields, err := parseCompositeLiteral(literal)
+	if err != nil {
+		return err
+	}
+	if len(fields) != 2 {
+		return fmt.Errorf("PointType: expected 2 fields, got %d", len(fields))
+	}
+	*c = PointType{}
+	if err := scanTextField(&c.X, fields[0]); err != nil {
+		return fmt.Errorf("X: %w", err)
+	}
+	if err := scanTextField(&c.Y, fields[1]); err != nil {
+		return fmt.Errorf("Y: %w", err)
+	}
+	return nil
+}
+
+func (c PointType) Value() (driver.Value, error) {
+	return compositeLiteral(c.X, c.Y)
+}
+
+// parseCompositeLiteral splits the text format of a composite value into its
+// fields. A nil field is NULL.
+func parseCompositeLiteral(s string) ([]*string, error) {
+	if len(s) < 2 || s[0] != '(' || s[len(s)-1] != ')' {
+		return nil, fmt.Errorf("invalid composite literal: %q", s)
+	}
+	return splitTextFields(s[1 : len(s)-1]), nil
+}
+
+// compositeLiteral returns the text format of a composite value.
+func compositeLiteral(fields ...interface{}) (driver.Value, error) {
+	var b strings.Builder
+	b.WriteByte('(')
+	for i, field := range fields {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		if err := writeTextField(&b, field); err != nil {
+			return nil, err
+		}
+	}
+	b.WriteByte(')')
+	return b.String(), nil
+}
+
+// splitTextFields splits the fields of a composite value or the bounds of a
+// range, which are separated by commas and may be quoted. A nil field is NULL.
+func splitTextFields(s string) []*string {
+	var fields []*string
+	var b strings.Builder
+	quoted, present := false, false
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '\\' && i+1 < len(s):
+			i++
+			b.WriteByte(s[i])
+			present = true
+		case c == '"' && quoted && i+1 < len(s) && s[i+1] == '"':
+			i++
+			b.WriteByte('"')
+		case c == '"':
+			quoted = !quoted
+			present = true
+		case c == ',' && !quoted:
+			fields = append(fields, textField(&b, present))
+			present = false
+		default:
+			b.WriteByte(c)
+			present = true
+		}
+	}
+	return append(fields, textField(&b, present))
+}
+
+func textField(b *strings.Builder, present bool) *string {
+	if !present {
+		return nil
+	}
+	s := b.String()
+	b.Reset()
+	return &s
+}
+
+// scanTextField converts the text of a field into dst. NULL fields leave dst
+// unchanged.
+func scanTextField(dst interface{}, src *string) error {
+	if src == nil {
+		return nil
+	}
+	switch d := dst.(type) {
+	case *json.RawMessage:
+		*d = json.RawMessage(*src)
+		return nil
+	case *time.Time:
+		t, err := parseTextTime(*src)
+		*d = t
+		return err
+	case *sql.NullTime:
+		t, err := parseTextTime(*src)
+		*d = sql.NullTime{Time: t, Valid: err == nil}
+		return err
+	case sql.Scanner:
+		return d.Scan(*src)
+	}
+	v := reflect.ValueOf(dst).Elem()
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(*src)
+	case reflect.Slice:
+		if v.Type().Elem().Kind() != reflect.Uint8 {
+			return fmt.Errorf("unsupported field type %T", dst)
+		}
+		b, err := hex.DecodeString(strings.TrimPrefix(*src, "\\x"))
+		if err != nil {
+			return err
+		}
+		v.SetBytes(b)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(*src)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(*src, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(*src, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	case reflect.Interface:
+		v.Set(reflect.ValueOf(*src))
+	default:
+		return fmt.Errorf("unsupported field type %T", dst)
+	}
+	return nil
+}
+
+func parseTextTime(s string) (time.Time, error) {
+	for _, layout := range []string{
+		"2006-01-02 15:04:05.999999999Z07",
+		"2006-01-02 15:04:05.999999999Z07:00",
+		"2006-01-02 15:04:05.999999999",
+		"2006-01-02",
+		"15:04:05.999999999",
+	} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time: %q", s)
+}
+
+// writeTextField writes a quoted field of a composite value or a bound of a
+// range. NULL is written as nothing.
+func writeTextField(b *strings.Builder, field interface{}) error {
+	if v, ok := field.(driver.Valuer); ok {
+		val, err := v.Value()
+		if err != nil {
+			return err
+		}
+		field = val
+	}
+	var s string
+	switch f := field.(type) {
+	case nil:
+		return nil
+	case string:
+		s = f
+	case json.RawMessage:
+		if f == nil {
+			return nil
+		}
+		s = string(f)
+	case []byte:
+		s = "\\x" + hex.EncodeToString(f)
+	case time.Time:
+		s = f.Format("2006-01-02 15:04:05.999999999Z07:00")
+	default:
+		v := reflect.ValueOf(field)
+		switch {
+		case v.Kind() == reflect.String:
+			s = v.String()
+		case v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8:
+			s = "\\x" + hex.EncodeToString(v.Bytes())
+		default:
+			s = fmt.Sprint(field)
+		}
+	}
+	b.WriteByte('"')
+	b.WriteString(strings.NewReplacer("\\", "\\\\", "\"", "\\\"").Replace(s))
+	b.WriteByte('"')
+	return nil
+}
+
 type FooPath struct {
-	PointOne sql.NullString
-	PointTwo sql.NullString
+	PointOne PointType
+	PointTwo FooPointType
 }
@@ -0,0 +1,94 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
+)
+
+const createVenue = `-- name: CreateVenue :one
+INSERT INTO venues (location, addresses) VALUES ($1, $2) RETURNING id, location, addresses
+`
+
+type CreateVenueParams struct {
+	Location  Location
+	Addresses []Address
+}
+
+func (q *Queries) CreateVenue(ctx context.Context, arg CreateVenueParams) (Venue, error) {
+	row := q.db.QueryRowContext(ctx, createVenue, arg.Location, pq.Array(arg.Addresses))
+	var i Venue
+	err := row.Scan(&i.ID, &i.Location, pq.Array(&i.Addresses))
+	return i, err
+}
+
+const listVenuesByCity = `-- name: ListVenuesByCity :many
+SELECT id, ((location).address).city, (addresses[1]).zip
+FROM venues
+WHERE ((location).address).city = $1
+`
+
+type ListVenuesByCityRow struct {
+	ID   int32
+	City sql.NullString
+	Zip  sql.NullInt32
+}
+
+func (q *Queries) ListVenuesByCity(ctx context.Context, city sql.NullString) ([]ListVenuesByCityRow, error) {
+	rows, err := q.db.QueryContext(ctx, listVenuesByCity, city)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListVenuesByCityRow
+	for rows.Next() {
+		var i ListVenuesByCityRow
+		if err := rows.Scan(&i.ID, &i.City, &i.Zip); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listVenuesByStatus = `-- name: ListVenuesByStatus :many
+SELECT id, (location).status AS venue_status FROM venues WHERE (location).status = $1
+`
+
+type ListVenuesByStatusRow struct {
+	ID          int32
+	VenueStatus Status
+}
+
+func (q *Queries) ListVenuesByStatus(ctx context.Context, status Status) ([]ListVenuesByStatusRow, error) {
+	rows, err := q.db.QueryContext(ctx, listVenuesByStatus, status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListVenuesByStatusRow
+	for rows.Next() {
+		var i ListVenuesByStatusRow
+		if err := rows.Scan(&i.ID, &i.VenueStatus); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,10 @@
+-- name: CreateVenue :one
+INSERT INTO venues (location, addresses) VALUES ($1, $2) RETURNING *;
+
+-- name: ListVenuesByCity :many
+SELECT id, ((location).address).city, (addresses[1]).zip
+FROM venues
+WHERE ((location).address).city = $1;
+
+-- name: ListVenuesByStatus :many
+SELECT id, (location).status AS venue_status FROM venues WHERE (location).status = $1;
@@ -0,0 +1,21 @@
+CREATE TYPE status AS ENUM ('open', 'closed');
+
+CREATE TYPE address AS (
+    street text,
+    city text,
+    zip integer,
+    lines text[]
+);
+
+CREATE TYPE location AS (
+    address address,
+    status status,
+    updated_at timestamp,
+    details jsonb
+);
+
+CREATE TABLE venues (
+    id SERIAL PRIMARY KEY,
+    location location NOT NULL,
+    addresses address[] NOT NULL
+);
@@ -0,0 +1,112 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+)
+
+const ancestors = `-- name: Ancestors :many
+WITH RECURSIVE ancestors AS (
+    SELECT id, parent_id, name FROM nodes WHERE id = $1
+    UNION
+    SELECT n.id, n.parent_id, n.name FROM nodes n, ancestors a WHERE n.id = a.parent_id
+)
+SELECT name FROM ancestors
+`
+
+func (q *Queries) Ancestors(ctx context.Context, id int32) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, ancestors, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		items = append(items, name)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const renamed = `-- name: Renamed :many
+WITH named(node_id, node_name) AS (
+    SELECT id, name FROM nodes
+)
+SELECT node_id, node_name FROM named
+`
+
+type RenamedRow struct {
+	NodeID   int32
+	NodeName string
+}
+
+func (q *Queries) Renamed(ctx context.Context) ([]RenamedRow, error) {
+	rows, err := q.db.QueryContext(ctx, renamed)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RenamedRow
+	for rows.Next() {
+		var i RenamedRow
+		if err := rows.Scan(&i.NodeID, &i.NodeName); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const tree = `-- name: Tree :many
+WITH RECURSIVE tree(node, depth) AS (
+    SELECT id, 0 FROM nodes WHERE parent_id IS NULL
+    UNION ALL
+    SELECT n.id, t.depth + 1 FROM nodes n JOIN tree t ON n.parent_id = t.node
+)
+SELECT node, depth FROM tree
+`
+
+type TreeRow struct {
+	Node  int32
+	Depth int32
+}
+
+func (q *Queries) Tree(ctx context.Context) ([]TreeRow, error) {
+	rows, err := q.db.QueryContext(ctx, tree)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TreeRow
+	for rows.Next() {
+		var i TreeRow
+		if err := rows.Scan(&i.Node, &i.Depth); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
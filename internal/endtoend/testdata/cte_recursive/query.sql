@@ -0,0 +1,23 @@
+CREATE TABLE nodes (id integer not null, parent_id integer, name text not null);
+
+-- name: Tree :many
+WITH RECURSIVE tree(node, depth) AS (
+    SELECT id, 0 FROM nodes WHERE parent_id IS NULL
+    UNION ALL
+    SELECT n.id, t.depth + 1 FROM nodes n JOIN tree t ON n.parent_id = t.node
+)
+SELECT * FROM tree;
+
+-- name: Ancestors :many
+WITH RECURSIVE ancestors AS (
+    SELECT id, parent_id, name FROM nodes WHERE id = $1
+    UNION
+    SELECT n.id, n.parent_id, n.name FROM nodes n, ancestors a WHERE n.id = a.parent_id
+)
+SELECT name FROM ancestors;
+
+-- name: Renamed :many
+WITH named(node_id, node_name) AS (
+    SELECT id, name FROM nodes
+)
+SELECT node_id, node_name FROM named;
@@ -0,0 +1,31 @@
+CREATE TABLE nodes (id integer not null, parent_id integer, name text not null);
+
+-- name: ColumnCount :many
+WITH RECURSIVE tree AS (
+    SELECT id FROM nodes
+    UNION ALL
+    SELECT n.id, n.name FROM nodes n JOIN tree t ON n.parent_id = t.id
+)
+SELECT * FROM tree;
+
+-- name: ColumnType :many
+WITH RECURSIVE tree AS (
+    SELECT id FROM nodes
+    UNION ALL
+    SELECT n.name FROM nodes n JOIN tree t ON n.parent_id = t.id
+)
+SELECT * FROM tree;
+
+-- name: WidenedType :many
+WITH RECURSIVE tree AS (
+    SELECT id FROM nodes
+    UNION ALL
+    SELECT t.id::bigint FROM tree t
+)
+SELECT * FROM tree;
+
+-- name: TooManyAliases :many
+WITH named(a, b, c) AS (
+    SELECT id, name FROM nodes
+)
+SELECT * FROM named;
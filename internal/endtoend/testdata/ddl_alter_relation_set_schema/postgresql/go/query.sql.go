@@ -0,0 +1,62 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+)
+
+const listEvents = `-- name: ListEvents :many
+SELECT id, name FROM archive.events
+`
+
+func (q *Queries) ListEvents(ctx context.Context) ([]ArchiveEvent, error) {
+	rows, err := q.db.QueryContext(ctx, listEvents)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ArchiveEvent
+	for rows.Next() {
+		var i ArchiveEvent
+		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRemoteEvents = `-- name: ListRemoteEvents :many
+SELECT id, name FROM archive.remote_events
+`
+
+func (q *Queries) ListRemoteEvents(ctx context.Context) ([]ArchiveRemoteEvent, error) {
+	rows, err := q.db.QueryContext(ctx, listRemoteEvents)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ArchiveRemoteEvent
+	for rows.Next() {
+		var i ArchiveRemoteEvent
+		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,5 @@
+-- name: ListEvents :many
+SELECT * FROM archive.events;
+
+-- name: ListRemoteEvents :many
+SELECT * FROM archive.remote_events;
@@ -0,0 +1,12 @@
+CREATE SCHEMA archive;
+CREATE TABLE events (id SERIAL PRIMARY KEY, name text NOT NULL);
+CREATE FOREIGN TABLE remote_events (id integer NOT NULL, name text) SERVER remote;
+CREATE VIEW event_names AS SELECT name FROM events;
+CREATE MATERIALIZED VIEW event_counts AS SELECT count(*) FROM events;
+CREATE SEQUENCE event_seq;
+ALTER TABLE events SET SCHEMA archive;
+ALTER FOREIGN TABLE remote_events SET SCHEMA archive;
+ALTER VIEW event_names SET SCHEMA archive;
+ALTER MATERIALIZED VIEW event_counts SET SCHEMA archive;
+ALTER SEQUENCE event_seq SET SCHEMA archive;
+ALTER TABLE IF EXISTS missing SET SCHEMA archive;
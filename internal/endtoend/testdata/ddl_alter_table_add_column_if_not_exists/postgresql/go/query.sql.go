@@ -0,0 +1,35 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+)
+
+const listVenues = `-- name: ListVenues :many
+SELECT id, name, city FROM venues
+`
+
+func (q *Queries) ListVenues(ctx context.Context) ([]Venue, error) {
+	rows, err := q.db.QueryContext(ctx, listVenues)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Venue
+	for rows.Next() {
+		var i Venue
+		if err := rows.Scan(&i.ID, &i.Name, &i.City); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,2 @@
+-- name: ListVenues :many
+SELECT * FROM venues;
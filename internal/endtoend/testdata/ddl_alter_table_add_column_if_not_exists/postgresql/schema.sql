@@ -0,0 +1,6 @@
+CREATE TABLE venues (
+    id SERIAL PRIMARY KEY,
+    name text NOT NULL
+);
+ALTER TABLE venues ADD COLUMN IF NOT EXISTS name integer;
+ALTER TABLE venues ADD COLUMN IF NOT EXISTS city text;
@@ -0,0 +1,43 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+)
+
+const upsertCity = `-- name: UpsertCity :exec
+INSERT INTO cities (slug, name) VALUES ($1, $2)
+ON CONFLICT ON CONSTRAINT cities_pkey DO NOTHING
+`
+
+type UpsertCityParams struct {
+	Slug string
+	Name string
+}
+
+func (q *Queries) UpsertCity(ctx context.Context, arg UpsertCityParams) error {
+	_, err := q.db.ExecContext(ctx, upsertCity, arg.Slug, arg.Name)
+	return err
+}
+
+const upsertVenue = `-- name: UpsertVenue :one
+INSERT INTO venues (id, city, name) VALUES ($1, $2, $3)
+ON CONFLICT (city, name) DO UPDATE SET id = excluded.id
+RETURNING id, city, name
+`
+
+type UpsertVenueParams struct {
+	ID   int32
+	City sql.NullString
+	Name string
+}
+
+func (q *Queries) UpsertVenue(ctx context.Context, arg UpsertVenueParams) (Venue, error) {
+	row := q.db.QueryRowContext(ctx, upsertVenue, arg.ID, arg.City, arg.Name)
+	var i Venue
+	err := row.Scan(&i.ID, &i.City, &i.Name)
+	return i, err
+}
@@ -0,0 +1,8 @@
+-- name: UpsertVenue :one
+INSERT INTO venues (id, city, name) VALUES ($1, $2, $3)
+ON CONFLICT (city, name) DO UPDATE SET id = excluded.id
+RETURNING *;
+
+-- name: UpsertCity :exec
+INSERT INTO cities (slug, name) VALUES ($1, $2)
+ON CONFLICT ON CONSTRAINT cities_pkey DO NOTHING;
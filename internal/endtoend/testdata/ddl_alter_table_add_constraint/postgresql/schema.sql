@@ -0,0 +1,13 @@
+CREATE TABLE cities (
+    slug text,
+    name text NOT NULL
+);
+CREATE TABLE venues (
+    id integer,
+    city text,
+    name text NOT NULL
+);
+ALTER TABLE cities ADD PRIMARY KEY (slug);
+ALTER TABLE venues ADD CONSTRAINT venues_pkey PRIMARY KEY (id);
+ALTER TABLE venues ADD CONSTRAINT venues_city_fkey FOREIGN KEY (city) REFERENCES cities (slug);
+ALTER TABLE venues ADD UNIQUE (city, name);
@@ -0,0 +1,63 @@
+// Code generated by sqlc. DO NOT EDIT.
+
+package querytest
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+type VenuesCategory string
+
+const (
+	VenuesCategoryBar  VenuesCategory = "bar"
+	VenuesCategoryClub VenuesCategory = "club"
+)
+
+func (e *VenuesCategory) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = VenuesCategory(s)
+	case string:
+		*e = VenuesCategory(s)
+	default:
+		return fmt.Errorf("unsupported scan type for VenuesCategory: %T", src)
+	}
+	return nil
+}
+
+type VenuesKind string
+
+const (
+	VenuesKindIndoor  VenuesKind = "indoor"
+	VenuesKindOutdoor VenuesKind = "outdoor"
+)
+
+func (e *VenuesKind) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = VenuesKind(s)
+	case string:
+		*e = VenuesKind(s)
+	default:
+		return fmt.Errorf("unsupported scan type for VenuesKind: %T", src)
+	}
+	return nil
+}
+
+type Show struct {
+	ID       uint64
+	Venue    uint64
+	StartsAt time.Time
+}
+
+type Venue struct {
+	ID       uint64
+	Title    string
+	Category VenuesCategory
+	Town     string
+	Country  string
+	Capacity sql.NullInt64
+	Kind     VenuesKind
+}
@@ -0,0 +1,79 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+)
+
+const createVenue = `-- name: CreateVenue :execresult
+INSERT INTO venues (title, category, town, country, capacity, kind) VALUES (?, ?, ?, ?, ?, ?)
+`
+
+type CreateVenueParams struct {
+	Title    string
+	Category VenuesCategory
+	Town     string
+	Country  string
+	Capacity sql.NullInt64
+	Kind     VenuesKind
+}
+
+func (q *Queries) CreateVenue(ctx context.Context, arg CreateVenueParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, createVenue,
+		arg.Title,
+		arg.Category,
+		arg.Town,
+		arg.Country,
+		arg.Capacity,
+		arg.Kind,
+	)
+}
+
+const getVenue = `-- name: GetVenue :one
+SELECT id, title, category, town, country, capacity, kind FROM venues WHERE id = ?
+`
+
+func (q *Queries) GetVenue(ctx context.Context, id uint64) (Venue, error) {
+	row := q.db.QueryRowContext(ctx, getVenue, id)
+	var i Venue
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Category,
+		&i.Town,
+		&i.Country,
+		&i.Capacity,
+		&i.Kind,
+	)
+	return i, err
+}
+
+const listShows = `-- name: ListShows :many
+SELECT id, venue, starts_at FROM shows WHERE venue = ?
+`
+
+func (q *Queries) ListShows(ctx context.Context, venue uint64) ([]Show, error) {
+	rows, err := q.db.QueryContext(ctx, listShows, venue)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Show
+	for rows.Next() {
+		var i Show
+		if err := rows.Scan(&i.ID, &i.Venue, &i.StartsAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,8 @@
+/* name: GetVenue :one */
+SELECT * FROM venues WHERE id = ?;
+
+/* name: CreateVenue :execresult */
+INSERT INTO venues (title, category, town, country, capacity, kind) VALUES (?, ?, ?, ?, ?, ?);
+
+/* name: ListShows :many */
+SELECT id, venue, starts_at FROM shows WHERE venue = ?;
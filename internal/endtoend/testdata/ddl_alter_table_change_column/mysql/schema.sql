@@ -0,0 +1,38 @@
+CREATE TABLE venues (
+    id      serial PRIMARY KEY,
+    name    text,
+    kind    enum('bar', 'club'),
+    city    varchar(255) NOT NULL,
+    country varchar(255)
+);
+
+CREATE TABLE shows (
+    id       serial PRIMARY KEY,
+    venue_id bigint unsigned NOT NULL
+);
+
+ALTER TABLE venues
+    CHANGE COLUMN name title varchar(255) NOT NULL,
+    MODIFY COLUMN country char(2) NOT NULL,
+    RENAME COLUMN city TO town,
+    ADD COLUMN capacity int unsigned;
+
+-- The type of an ENUM column follows the column when it's renamed
+ALTER TABLE venues RENAME COLUMN kind TO category;
+ALTER TABLE venues ADD COLUMN kind enum('indoor', 'outdoor') NOT NULL;
+
+ALTER TABLE shows
+    ADD COLUMN starts_at datetime NOT NULL,
+    ADD INDEX shows_starts_at (starts_at),
+    ADD CONSTRAINT shows_venue_fk FOREIGN KEY (venue_id) REFERENCES venues (id);
+
+ALTER TABLE shows CHANGE venue_id venue bigint unsigned NOT NULL;
+ALTER TABLE shows DROP INDEX shows_starts_at, DROP FOREIGN KEY shows_venue_fk;
+
+-- An unnamed unique index is named after its first column, with a number
+-- added if another index has the name
+ALTER TABLE venues ADD UNIQUE (town);
+ALTER TABLE venues ADD UNIQUE (town);
+ALTER TABLE venues MODIFY country char(2) NOT NULL UNIQUE;
+ALTER TABLE venues MODIFY country char(2) NOT NULL UNIQUE;
+ALTER TABLE venues DROP INDEX town_2, DROP INDEX country_2;
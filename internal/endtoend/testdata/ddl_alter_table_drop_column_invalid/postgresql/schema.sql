@@ -0,0 +1,9 @@
+CREATE TABLE cities (
+    id SERIAL PRIMARY KEY,
+    slug text NOT NULL UNIQUE
+);
+CREATE TABLE venues (
+    id SERIAL PRIMARY KEY,
+    city text REFERENCES cities (slug)
+);
+ALTER TABLE cities DROP COLUMN slug;
@@ -0,0 +1,5 @@
+CREATE TABLE venues (
+    id integer NOT NULL,
+    CONSTRAINT venues_id_pk PRIMARY KEY (id)
+);
+ALTER TABLE venues DROP CONSTRAINT venues_pkey;
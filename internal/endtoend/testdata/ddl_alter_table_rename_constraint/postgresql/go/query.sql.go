@@ -0,0 +1,18 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+)
+
+const upsertVenue = `-- name: UpsertVenue :exec
+INSERT INTO venues (slug) VALUES ($1)
+ON CONFLICT ON CONSTRAINT venues_slug_unique DO NOTHING
+`
+
+func (q *Queries) UpsertVenue(ctx context.Context, slug string) error {
+	_, err := q.db.ExecContext(ctx, upsertVenue, slug)
+	return err
+}
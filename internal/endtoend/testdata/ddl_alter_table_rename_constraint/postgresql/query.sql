@@ -0,0 +1,3 @@
+-- name: UpsertVenue :exec
+INSERT INTO venues (slug) VALUES ($1)
+ON CONFLICT ON CONSTRAINT venues_slug_unique DO NOTHING;
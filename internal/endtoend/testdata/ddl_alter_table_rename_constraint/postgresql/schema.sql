@@ -0,0 +1,5 @@
+CREATE TABLE venues (
+    id SERIAL PRIMARY KEY,
+    slug text NOT NULL UNIQUE
+);
+ALTER TABLE venues RENAME CONSTRAINT venues_slug_key TO venues_slug_unique;
@@ -0,0 +1,19 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+)
+
+const createVenue = `-- name: CreateVenue :one
+INSERT INTO venues (name) VALUES ($1) RETURNING id, name, status
+`
+
+func (q *Queries) CreateVenue(ctx context.Context, name string) (Venue, error) {
+	row := q.db.QueryRowContext(ctx, createVenue, name)
+	var i Venue
+	err := row.Scan(&i.ID, &i.Name, &i.Status)
+	return i, err
+}
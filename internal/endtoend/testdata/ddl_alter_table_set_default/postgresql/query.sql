@@ -0,0 +1,2 @@
+-- name: CreateVenue :one
+INSERT INTO venues (name) VALUES ($1) RETURNING *;
@@ -0,0 +1,7 @@
+CREATE TABLE venues (
+    id SERIAL PRIMARY KEY,
+    name text NOT NULL,
+    status text
+);
+ALTER TABLE venues ALTER COLUMN status SET DEFAULT 'open';
+ALTER TABLE venues ALTER COLUMN name DROP DEFAULT;
@@ -0,0 +1,35 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+)
+
+const listBookings = `-- name: ListBookings :many
+SELECT id, room, nights FROM bookings
+`
+
+func (q *Queries) ListBookings(ctx context.Context) ([]Booking, error) {
+	rows, err := q.db.QueryContext(ctx, listBookings)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Booking
+	for rows.Next() {
+		var i Booking
+		if err := rows.Scan(&i.ID, &i.Room, &i.Nights); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
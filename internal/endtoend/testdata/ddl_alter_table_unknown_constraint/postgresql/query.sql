@@ -0,0 +1,2 @@
+-- name: ListBookings :many
+SELECT * FROM bookings;
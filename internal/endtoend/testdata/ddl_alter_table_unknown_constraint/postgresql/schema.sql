@@ -0,0 +1,18 @@
+CREATE TABLE bookings (
+    id SERIAL PRIMARY KEY,
+    room integer NOT NULL,
+    nights integer NOT NULL,
+    CHECK (nights > 0),
+    CHECK (nights < 30),
+    EXCLUDE USING gist (room WITH =)
+);
+ALTER TABLE bookings DROP CONSTRAINT bookings_check1;
+ALTER TABLE bookings RENAME CONSTRAINT bookings_nights_check TO bookings_nights_positive;
+ALTER TABLE bookings DROP CONSTRAINT bookings_room_excl;
+ALTER INDEX bookings_pkey RENAME TO bookings_id_pk;
+ALTER TABLE bookings DROP CONSTRAINT bookings_id_pk;
+
+CREATE TABLE guests (
+    the_name_of_the_guest_as_written_on_the_registration_card text UNIQUE
+);
+ALTER TABLE guests DROP CONSTRAINT guests_the_name_of_the_guest_as_written_on_the_registration_key;
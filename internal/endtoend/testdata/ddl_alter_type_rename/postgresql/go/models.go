@@ -0,0 +1,31 @@
+// Code generated by sqlc. DO NOT EDIT.
+
+package querytest
+
+import (
+	"fmt"
+)
+
+type LogStatus string
+
+const (
+	LogStatusSTART LogStatus = "START"
+	LogStatusSTOP  LogStatus = "STOP"
+)
+
+func (e *LogStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = LogStatus(s)
+	case string:
+		*e = LogStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for LogStatus: %T", src)
+	}
+	return nil
+}
+
+type LogLine struct {
+	ID     int32
+	Status LogStatus
+}
@@ -0,0 +1,35 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+)
+
+const listByStatus = `-- name: ListByStatus :many
+SELECT id, status FROM log_lines WHERE status = $1
+`
+
+func (q *Queries) ListByStatus(ctx context.Context, status LogStatus) ([]LogLine, error) {
+	rows, err := q.db.QueryContext(ctx, listByStatus, status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []LogLine
+	for rows.Next() {
+		var i LogLine
+		if err := rows.Scan(&i.ID, &i.Status); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
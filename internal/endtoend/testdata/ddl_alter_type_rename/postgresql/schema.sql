@@ -0,0 +1,6 @@
+CREATE TYPE event AS ENUM ('START', 'STOP');
+CREATE TABLE log_lines (
+    id SERIAL PRIMARY KEY,
+    status event NOT NULL
+);
+ALTER TYPE event RENAME TO log_status;
@@ -0,0 +1,31 @@
+// Code generated by sqlc. DO NOT EDIT.
+
+package querytest
+
+import (
+	"fmt"
+)
+
+type LogsEvent string
+
+const (
+	LogsEventSTART LogsEvent = "START"
+	LogsEventSTOP  LogsEvent = "STOP"
+)
+
+func (e *LogsEvent) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = LogsEvent(s)
+	case string:
+		*e = LogsEvent(s)
+	default:
+		return fmt.Errorf("unsupported scan type for LogsEvent: %T", src)
+	}
+	return nil
+}
+
+type LogLine struct {
+	ID     int32
+	Status LogsEvent
+}
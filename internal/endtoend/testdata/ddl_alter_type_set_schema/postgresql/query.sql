@@ -0,0 +1,2 @@
+-- name: ListByStatus :many
+SELECT * FROM log_lines WHERE status = $1;
@@ -0,0 +1,7 @@
+CREATE SCHEMA logs;
+CREATE TYPE event AS ENUM ('START', 'STOP');
+CREATE TABLE log_lines (
+    id SERIAL PRIMARY KEY,
+    status event NOT NULL
+);
+ALTER TYPE event SET SCHEMA logs;
@@ -0,0 +1,57 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
+)
+
+const createUser = `-- name: CreateUser :one
+INSERT INTO users (email, handle, age, tags) VALUES ($1, $2, $3, $4) RETURNING id, email, handle, age, tags
+`
+
+type CreateUserParams struct {
+	Email  string
+	Handle string
+	Age    sql.NullInt32
+	Tags   []string
+}
+
+func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, error) {
+	row := q.db.QueryRowContext(ctx, createUser,
+		arg.Email,
+		arg.Handle,
+		arg.Age,
+		pq.Array(arg.Tags),
+	)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.Handle,
+		&i.Age,
+		pq.Array(&i.Tags),
+	)
+	return i, err
+}
+
+const getUserByEmail = `-- name: GetUserByEmail :one
+SELECT id, email, handle, age, tags FROM users WHERE lower(email) = lower($1)
+`
+
+func (q *Queries) GetUserByEmail(ctx context.Context, lower string) (User, error) {
+	row := q.db.QueryRowContext(ctx, getUserByEmail, lower)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.Handle,
+		&i.Age,
+		pq.Array(&i.Tags),
+	)
+	return i, err
+}
@@ -0,0 +1,5 @@
+-- name: GetUserByEmail :one
+SELECT * FROM users WHERE lower(email) = lower($1);
+
+-- name: CreateUser :one
+INSERT INTO users (email, handle, age, tags) VALUES ($1, $2, $3, $4) RETURNING *;
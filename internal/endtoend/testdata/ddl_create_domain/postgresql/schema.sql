@@ -0,0 +1,20 @@
+CREATE EXTENSION IF NOT EXISTS citext;
+CREATE DOMAIN email AS citext CHECK (value ~ '^[^@]+@[^@]+$');
+CREATE DOMAIN slug AS varchar(64) NOT NULL;
+CREATE DOMAIN positive_int AS integer CONSTRAINT positive_int_check CHECK (value > 0);
+CREATE DOMAIN tag_list AS text[];
+CREATE DOMAIN legacy AS text;
+
+ALTER DOMAIN email SET NOT NULL;
+ALTER DOMAIN positive_int DROP CONSTRAINT positive_int_check;
+ALTER DOMAIN positive_int ADD CONSTRAINT positive_int_check CHECK (value > 0);
+ALTER DOMAIN legacy RENAME TO old_text;
+DROP DOMAIN old_text;
+
+CREATE TABLE users (
+    id SERIAL PRIMARY KEY,
+    email email,
+    handle slug,
+    age positive_int,
+    tags tag_list
+);
@@ -0,0 +1,91 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+)
+
+const createShirt = `-- name: CreateShirt :exec
+INSERT INTO shirts (id, size, tags, color, extras) VALUES (?, ?, ?, ?, ?)
+`
+
+type CreateShirtParams struct {
+	ID     int64
+	Size   ShirtsSize
+	Tags   ShirtsTagsSet
+	Color  ShirtsColor
+	Extras NullShirtsExtrasSet
+}
+
+func (q *Queries) CreateShirt(ctx context.Context, arg CreateShirtParams) error {
+	_, err := q.db.ExecContext(ctx, createShirt,
+		arg.ID,
+		arg.Size,
+		arg.Tags,
+		arg.Color,
+		arg.Extras,
+	)
+	return err
+}
+
+const listDiscarded = `-- name: ListDiscarded :many
+SELECT status FROM discarded
+`
+
+func (q *Queries) ListDiscarded(ctx context.Context) ([]DiscardedStatusSet, error) {
+	rows, err := q.db.QueryContext(ctx, listDiscarded)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []DiscardedStatusSet
+	for rows.Next() {
+		var status DiscardedStatusSet
+		if err := rows.Scan(&status); err != nil {
+			return nil, err
+		}
+		items = append(items, status)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listShirts = `-- name: ListShirts :many
+SELECT id, size, tags, color, extras FROM shirts WHERE size = ?
+`
+
+func (q *Queries) ListShirts(ctx context.Context, size ShirtsSize) ([]Shirt, error) {
+	rows, err := q.db.QueryContext(ctx, listShirts, size)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Shirt
+	for rows.Next() {
+		var i Shirt
+		if err := rows.Scan(
+			&i.ID,
+			&i.Size,
+			&i.Tags,
+			&i.Color,
+			&i.Extras,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
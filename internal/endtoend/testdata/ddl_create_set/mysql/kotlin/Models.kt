@@ -0,0 +1,69 @@
+// Code generated by sqlc. DO NOT EDIT.
+
+package com.example.querytest
+
+enum class DiscardedStatus(val value: String) {
+  NEW("new"),
+  USED("used");
+
+  companion object {
+    private val map = DiscardedStatus.values().associateBy(DiscardedStatus::value)
+    fun lookup(value: String) = map[value]
+  }
+}
+
+enum class ShirtsColor(val value: String) {
+  RED("red"),
+  BLUE("blue");
+
+  companion object {
+    private val map = ShirtsColor.values().associateBy(ShirtsColor::value)
+    fun lookup(value: String) = map[value]
+  }
+}
+
+enum class ShirtsExtras(val value: String) {
+  GIFT_WRAP("gift-wrap"),
+  EXPRESS("express");
+
+  companion object {
+    private val map = ShirtsExtras.values().associateBy(ShirtsExtras::value)
+    fun lookup(value: String) = map[value]
+  }
+}
+
+enum class ShirtsSize(val value: String) {
+  X_SMALL("x-small"),
+  SMALL("small"),
+  MEDIUM("medium"),
+  LARGE("large");
+
+  companion object {
+    private val map = ShirtsSize.values().associateBy(ShirtsSize::value)
+    fun lookup(value: String) = map[value]
+  }
+}
+
+enum class ShirtsTags(val value: String) {
+  COTTON("cotton"),
+  V_NECK("v-neck"),
+  SALE("sale");
+
+  companion object {
+    private val map = ShirtsTags.values().associateBy(ShirtsTags::value)
+    fun lookup(value: String) = map[value]
+  }
+}
+
+data class Discarded (
+  val status: Set<DiscardedStatus>
+)
+
+data class Shirt (
+  val id: Long,
+  val size: ShirtsSize,
+  val tags: Set<ShirtsTags>,
+  val color: ShirtsColor?,
+  val extras: Set<ShirtsExtras>?
+)
+
@@ -0,0 +1,25 @@
+// Code generated by sqlc. DO NOT EDIT.
+
+package com.example.querytest
+
+import java.sql.Connection
+import java.sql.SQLException
+import java.sql.Statement
+
+interface Queries {
+  @Throws(SQLException::class)
+  fun createShirt(
+      id: Long,
+      size: ShirtsSize,
+      tags: Set<ShirtsTags>,
+      color: ShirtsColor?,
+      extras: Set<ShirtsExtras>?)
+  
+  @Throws(SQLException::class)
+  fun listDiscarded(): List<Set<DiscardedStatus>>
+  
+  @Throws(SQLException::class)
+  fun listShirts(size: ShirtsSize): List<Shirt>
+  
+}
+
@@ -0,0 +1,75 @@
+// Code generated by sqlc. DO NOT EDIT.
+
+package com.example.querytest
+
+import java.sql.Connection
+import java.sql.SQLException
+import java.sql.Statement
+
+const val createShirt = """-- name: createShirt :exec
+INSERT INTO shirts (id, size, tags, color, extras) VALUES (?, ?, ?, ?, ?)
+"""
+
+const val listDiscarded = """-- name: listDiscarded :many
+SELECT status FROM discarded
+"""
+
+const val listShirts = """-- name: listShirts :many
+SELECT id, size, tags, color, extras FROM shirts WHERE size = ?
+"""
+
+class QueriesImpl(private val conn: Connection) : Queries {
+
+  @Throws(SQLException::class)
+  override fun createShirt(
+      id: Long,
+      size: ShirtsSize,
+      tags: Set<ShirtsTags>,
+      color: ShirtsColor?,
+      extras: Set<ShirtsExtras>?) {
+    conn.prepareStatement(createShirt).use { stmt ->
+      stmt.setLong(1, id)
+          stmt.setString(2, size.value)
+          stmt.setString(3, tags.joinToString(",") { v -> v.value })
+          stmt.setString(4, color?.value)
+          stmt.setString(5, extras?.joinToString(",") { v -> v.value })
+
+      stmt.execute()
+    }
+  }
+
+  @Throws(SQLException::class)
+  override fun listDiscarded(): List<Set<DiscardedStatus>> {
+    return conn.prepareStatement(listDiscarded).use { stmt ->
+      
+      val results = stmt.executeQuery()
+      val ret = mutableListOf<Set<DiscardedStatus>>()
+      while (results.next()) {
+          ret.add(results.getString(1).split(",").filter { v -> v != "" }.map { v -> DiscardedStatus.lookup(v)!! }.toSet())
+      }
+      ret
+    }
+  }
+
+  @Throws(SQLException::class)
+  override fun listShirts(size: ShirtsSize): List<Shirt> {
+    return conn.prepareStatement(listShirts).use { stmt ->
+      stmt.setString(1, size.value)
+
+      val results = stmt.executeQuery()
+      val ret = mutableListOf<Shirt>()
+      while (results.next()) {
+          ret.add(Shirt(
+                results.getLong(1),
+                ShirtsSize.lookup(results.getString(2))!!,
+                results.getString(3).split(",").filter { v -> v != "" }.map { v -> ShirtsTags.lookup(v)!! }.toSet(),
+                results.getString(4)?.let { v -> ShirtsColor.lookup(v)!! },
+                results.getString(5)?.split(",")?.filter { v -> v != "" }?.map { v -> ShirtsExtras.lookup(v)!! }?.toSet()
+            ))
+      }
+      ret
+    }
+  }
+
+}
+
@@ -0,0 +1,8 @@
+/* name: ListShirts :many */
+SELECT * FROM shirts WHERE size = ?;
+
+/* name: CreateShirt :exec */
+INSERT INTO shirts (id, size, tags, color, extras) VALUES (?, ?, ?, ?, ?);
+
+/* name: ListDiscarded :many */
+SELECT status FROM discarded;
@@ -0,0 +1,18 @@
+CREATE TABLE shirts (
+    id    BIGINT NOT NULL PRIMARY KEY,
+    size  ENUM('x-small', 'small', 'medium', 'large') NOT NULL,
+    tags  SET('cotton', 'v-neck', 'sale') NOT NULL
+);
+
+ALTER TABLE shirts ADD COLUMN color ENUM('red', 'blue');
+ALTER TABLE shirts ADD COLUMN extras SET('gift-wrap', 'express');
+
+CREATE TABLE discarded (
+    status ENUM('old') NOT NULL
+);
+
+DROP TABLE discarded;
+
+CREATE TABLE discarded (
+    status SET('new', 'used') NOT NULL
+);
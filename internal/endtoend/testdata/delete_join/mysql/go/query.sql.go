@@ -0,0 +1,64 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+)
+
+const deleteAuthorAndBooks = `-- name: DeleteAuthorAndBooks :exec
+DELETE a, b FROM authors a
+LEFT JOIN books b ON b.author_id = a.id
+WHERE a.id = ?
+`
+
+func (q *Queries) DeleteAuthorAndBooks(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, deleteAuthorAndBooks, id)
+	return err
+}
+
+const deleteBooksByAuthorName = `-- name: DeleteBooksByAuthorName :exec
+DELETE b FROM books b
+JOIN authors a ON a.id = b.author_id
+WHERE a.name = ?
+`
+
+func (q *Queries) DeleteBooksByAuthorName(ctx context.Context, name string) error {
+	_, err := q.db.ExecContext(ctx, deleteBooksByAuthorName, name)
+	return err
+}
+
+const deleteBooksUsing = `-- name: DeleteBooksUsing :execrows
+DELETE FROM books
+USING books JOIN authors ON authors.id = books.author_id
+WHERE authors.bio = ? AND books.available = ?
+`
+
+type DeleteBooksUsingParams struct {
+	Bio       sql.NullString
+	Available bool
+}
+
+func (q *Queries) DeleteBooksUsing(ctx context.Context, arg DeleteBooksUsingParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteBooksUsing, arg.Bio, arg.Available)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteBooksWithoutAuthor = `-- name: DeleteBooksWithoutAuthor :execrows
+DELETE b FROM books b
+LEFT JOIN authors a ON a.id = b.author_id
+WHERE a.id IS NULL
+`
+
+func (q *Queries) DeleteBooksWithoutAuthor(ctx context.Context) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteBooksWithoutAuthor)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
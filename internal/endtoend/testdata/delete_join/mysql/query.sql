@@ -0,0 +1,19 @@
+-- name: DeleteBooksByAuthorName :exec
+DELETE b FROM books b
+JOIN authors a ON a.id = b.author_id
+WHERE a.name = ?;
+
+-- name: DeleteBooksUsing :execrows
+DELETE FROM books
+USING books JOIN authors ON authors.id = books.author_id
+WHERE authors.bio = ? AND books.available = ?;
+
+-- name: DeleteAuthorAndBooks :exec
+DELETE a, b FROM authors a
+LEFT JOIN books b ON b.author_id = a.id
+WHERE a.id = ?;
+
+-- name: DeleteBooksWithoutAuthor :execrows
+DELETE b FROM books b
+LEFT JOIN authors a ON a.id = b.author_id
+WHERE a.id IS NULL;
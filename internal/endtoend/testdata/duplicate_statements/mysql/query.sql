@@ -0,0 +1,14 @@
+/* name: GetUser :one */
+SELECT id, name FROM users WHERE id = ?;
+
+/* name: GetUser :one */
+SELECT id, name FROM users WHERE id = ?;
+
+/* name: ListUsers :many */
+SELECT id, email FROM users;
+
+/* name: ListUsers :many */
+SELECT id, email FROM users;
+
+/* name: CountUsers :one */
+SELECT COUNT(*) FROM users
@@ -0,0 +1,20 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+)
+
+const insertBar = `-- name: InsertBar :execlastid
+INSERT INTO bar (name) VALUES (?)
+`
+
+func (q *Queries) InsertBar(ctx context.Context, name string) (int64, error) {
+	result, err := q.exec(ctx, q.insertBarStmt, insertBar, name)
+	if err != nil {
+		return 0, err
+	}
+	return result.LastInsertId()
+}
@@ -0,0 +1,4 @@
+CREATE TABLE bar (id serial not null, name text not null);
+
+/* name: InsertBar :execlastid */
+INSERT INTO bar (name) VALUES (?);
@@ -0,0 +1,39 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+)
+
+const insertBar = `-- name: InsertBar :execlastid
+INSERT INTO bar (name) VALUES (?)
+`
+
+func (q *Queries) InsertBar(ctx context.Context, name string) (int64, error) {
+	result, err := q.db.ExecContext(ctx, insertBar, name)
+	if err != nil {
+		return 0, err
+	}
+	return result.LastInsertId()
+}
+
+const insertBarWithID = `-- name: InsertBarWithID :execlastid
+INSERT INTO bar (id, name, label) VALUES (?2, ?1, ?)
+`
+
+type InsertBarWithIDParams struct {
+	Name  string
+	ID    int32
+	Label sql.NullString
+}
+
+func (q *Queries) InsertBarWithID(ctx context.Context, arg InsertBarWithIDParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, insertBarWithID, arg.Name, arg.ID, arg.Label)
+	if err != nil {
+		return 0, err
+	}
+	return result.LastInsertId()
+}
@@ -0,0 +1,7 @@
+CREATE TABLE bar (id integer primary key, name varchar(255) not null, label varchar(255));
+
+-- name: InsertBar :execlastid
+INSERT INTO bar (name) VALUES (?);
+
+-- name: InsertBarWithID :execlastid
+INSERT INTO bar (id, name, label) VALUES (?2, ?1, ?);
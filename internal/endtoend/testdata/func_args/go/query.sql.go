@@ -11,9 +11,9 @@ const makeIntervalDays = `-- name: MakeIntervalDays :one
 SELECT make_interval(days => $1::int)
 `
 
-func (q *Queries) MakeIntervalDays(ctx context.Context, dollar_1 int32) (int64, error) {
+func (q *Queries) MakeIntervalDays(ctx context.Context, dollar_1 int32) (Interval, error) {
 	row := q.db.QueryRowContext(ctx, makeIntervalDays, dollar_1)
-	var make_interval int64
+	var make_interval Interval
 	err := row.Scan(&make_interval)
 	return make_interval, err
 }
@@ -22,9 +22,9 @@ const makeIntervalMonths = `-- name: MakeIntervalMonths :one
 SELECT make_interval(months => $1::int)
 `
 
-func (q *Queries) MakeIntervalMonths(ctx context.Context, months int32) (int64, error) {
+func (q *Queries) MakeIntervalMonths(ctx context.Context, months int32) (Interval, error) {
 	row := q.db.QueryRowContext(ctx, makeIntervalMonths, months)
-	var make_interval int64
+	var make_interval Interval
 	err := row.Scan(&make_interval)
 	return make_interval, err
 }
@@ -33,9 +33,9 @@ const makeIntervalSecs = `-- name: MakeIntervalSecs :one
 SELECT make_interval(secs => $1)
 `
 
-func (q *Queries) MakeIntervalSecs(ctx context.Context, secs float64) (int64, error) {
+func (q *Queries) MakeIntervalSecs(ctx context.Context, secs float64) (Interval, error) {
 	row := q.db.QueryRowContext(ctx, makeIntervalSecs, secs)
-	var make_interval int64
+	var make_interval Interval
 	err := row.Scan(&make_interval)
 	return make_interval, err
 }
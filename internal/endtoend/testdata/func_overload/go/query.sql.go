@@ -0,0 +1,128 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"time"
+)
+
+const absoluteValues = `-- name: AbsoluteValues :one
+SELECT abs(-1) AS a, abs(-1.5) AS b, abs(weight) AS c FROM orders
+`
+
+type AbsoluteValuesRow struct {
+	A int32
+	B string
+	C float64
+}
+
+func (q *Queries) AbsoluteValues(ctx context.Context) (AbsoluteValuesRow, error) {
+	row := q.db.QueryRowContext(ctx, absoluteValues)
+	var i AbsoluteValuesRow
+	err := row.Scan(&i.A, &i.B, &i.C)
+	return i, err
+}
+
+const formatWeight = `-- name: FormatWeight :many
+SELECT to_char(weight, '999D99') FROM orders
+`
+
+func (q *Queries) FormatWeight(ctx context.Context) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, formatWeight)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var to_char string
+		if err := rows.Scan(&to_char); err != nil {
+			return nil, err
+		}
+		items = append(items, to_char)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const roundArg = `-- name: RoundArg :one
+SELECT round($1::float8)
+`
+
+func (q *Queries) RoundArg(ctx context.Context, dollar_1 float64) (float64, error) {
+	row := q.db.QueryRowContext(ctx, roundArg, dollar_1)
+	var round float64
+	err := row.Scan(&round)
+	return round, err
+}
+
+const roundPrices = `-- name: RoundPrices :many
+SELECT round(price, 2) AS price, round(weight) AS weight FROM orders
+`
+
+type RoundPricesRow struct {
+	Price  string
+	Weight float64
+}
+
+func (q *Queries) RoundPrices(ctx context.Context) ([]RoundPricesRow, error) {
+	rows, err := q.db.QueryContext(ctx, roundPrices)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RoundPricesRow
+	for rows.Next() {
+		var i RoundPricesRow
+		if err := rows.Scan(&i.Price, &i.Weight); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const truncateDates = `-- name: TruncateDates :many
+SELECT date_trunc('day', created_at) AS created, date_trunc('day', shipped_at) AS shipped FROM orders
+`
+
+type TruncateDatesRow struct {
+	Created time.Time
+	Shipped time.Time
+}
+
+func (q *Queries) TruncateDates(ctx context.Context) ([]TruncateDatesRow, error) {
+	rows, err := q.db.QueryContext(ctx, truncateDates)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TruncateDatesRow
+	for rows.Next() {
+		var i TruncateDatesRow
+		if err := rows.Scan(&i.Created, &i.Shipped); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,22 @@
+CREATE TABLE orders (
+    id         BIGSERIAL PRIMARY KEY,
+    price      NUMERIC NOT NULL,
+    weight     DOUBLE PRECISION NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    shipped_at TIMESTAMPTZ NOT NULL
+);
+
+-- name: RoundPrices :many
+SELECT round(price, 2) AS price, round(weight) AS weight FROM orders;
+
+-- name: TruncateDates :many
+SELECT date_trunc('day', created_at) AS created, date_trunc('day', shipped_at) AS shipped FROM orders;
+
+-- name: FormatWeight :many
+SELECT to_char(weight, '999D99') FROM orders;
+
+-- name: RoundArg :one
+SELECT round($1::float8);
+
+-- name: AbsoluteValues :one
+SELECT abs(-1) AS a, abs(-1.5) AS b, abs(weight) AS c FROM orders;
@@ -0,0 +1,70 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+)
+
+const createOrder = `-- name: CreateOrder :execresult
+INSERT INTO orders (price, quantity, note) VALUES (?, ?, ?)
+`
+
+type CreateOrderParams struct {
+	Price    uint32
+	Quantity uint32
+	Note     sql.NullString
+}
+
+func (q *Queries) CreateOrder(ctx context.Context, arg CreateOrderParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, createOrder, arg.Price, arg.Quantity, arg.Note)
+}
+
+const createOrderPositional = `-- name: CreateOrderPositional :execresult
+INSERT INTO orders VALUES (DEFAULT, ?, ?, DEFAULT, ?, DEFAULT, DEFAULT)
+`
+
+type CreateOrderPositionalParams struct {
+	Price    uint32
+	Quantity uint32
+	Note     sql.NullString
+}
+
+func (q *Queries) CreateOrderPositional(ctx context.Context, arg CreateOrderPositionalParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, createOrderPositional, arg.Price, arg.Quantity, arg.Note)
+}
+
+const getOrder = `-- name: GetOrder :one
+SELECT id, price, quantity, total, note, note_chars, discounted FROM orders WHERE id = ?
+`
+
+func (q *Queries) GetOrder(ctx context.Context, id uint64) (Order, error) {
+	row := q.db.QueryRowContext(ctx, getOrder, id)
+	var i Order
+	err := row.Scan(
+		&i.ID,
+		&i.Price,
+		&i.Quantity,
+		&i.Total,
+		&i.Note,
+		&i.NoteChars,
+		&i.Discounted,
+	)
+	return i, err
+}
+
+const updateNote = `-- name: UpdateNote :exec
+UPDATE orders SET note = ?, note_chars = DEFAULT WHERE id = ?
+`
+
+type UpdateNoteParams struct {
+	Note sql.NullString
+	ID   uint64
+}
+
+func (q *Queries) UpdateNote(ctx context.Context, arg UpdateNoteParams) error {
+	_, err := q.db.ExecContext(ctx, updateNote, arg.Note, arg.ID)
+	return err
+}
@@ -0,0 +1,11 @@
+/* name: GetOrder :one */
+SELECT * FROM orders WHERE id = ?;
+
+/* name: CreateOrder :execresult */
+INSERT INTO orders (price, quantity, note) VALUES (?, ?, ?);
+
+/* name: CreateOrderPositional :execresult */
+INSERT INTO orders VALUES (DEFAULT, ?, ?, DEFAULT, ?, DEFAULT, DEFAULT);
+
+/* name: UpdateNote :exec */
+UPDATE orders SET note = ?, note_chars = DEFAULT WHERE id = ?;
@@ -0,0 +1,11 @@
+/* name: InsertTotal :exec */
+INSERT INTO orders (price, quantity, total) VALUES (?, ?, ?);
+
+/* name: InsertPositional :exec */
+INSERT INTO orders VALUES (DEFAULT, ?, ?, 10, ?, DEFAULT, DEFAULT);
+
+/* name: InsertSelect :exec */
+INSERT INTO orders (price, quantity, note_chars) SELECT price, quantity, 1 FROM orders;
+
+/* name: UpdateTotal :exec */
+UPDATE orders SET total = ? WHERE id = ?;
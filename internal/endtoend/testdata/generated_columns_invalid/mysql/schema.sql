@@ -0,0 +1,10 @@
+CREATE TABLE orders (
+    id         serial,
+    price      int unsigned NOT NULL,
+    quantity   int unsigned NOT NULL,
+    total      bigint unsigned GENERATED ALWAYS AS (price * quantity) STORED NOT NULL,
+    note       text,
+    note_chars int AS (char_length(note)) VIRTUAL
+);
+
+ALTER TABLE orders ADD COLUMN discounted bigint unsigned AS (total * 9 / 10) NOT NULL;
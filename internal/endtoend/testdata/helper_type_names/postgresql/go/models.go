@@ -0,0 +1,390 @@
+// Code generated by sqlc. DO NOT EDIT.
+
+package querytest
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kyleconroy/sqlc/pkg/pginterval"
+)
+
+type PgTstzrange struct {
+	Lower          sql.NullTime
+	Upper          sql.NullTime
+	LowerInclusive bool
+	UpperInclusive bool
+	// LowerInfinity and UpperInfinity are -1 when the bound is -infinity and
+	// 1 when it's infinity, in which case Lower or Upper is ignored
+	LowerInfinity int
+	UpperInfinity int
+	Empty         bool
+}
+
+func (r *PgTstzrange) Scan(src interface{}) error {
+	var literal string
+	switch s := src.(type) {
+	case []byte:
+		literal = string(s)
+	case string:
+		literal = s
+	case nil:
+		*r = PgTstzrange{}
+		return nil
+	default:
+		return fmt.Errorf("unsupported scan type for PgTstzrange: %T", src)
+	}
+	bounds, lowerInclusive, upperInclusive, err := parseRangeLiteral(literal)
+	if err != nil {
+		return err
+	}
+	*r = PgTstzrange{LowerInclusive: lowerInclusive, UpperInclusive: upperInclusive, Empty: bounds == nil}
+	if r.Empty {
+		return nil
+	}
+	if r.LowerInfinity = rangeInfinity(bounds[0]); r.LowerInfinity != 0 {
+		bounds[0] = nil
+	}
+	if r.UpperInfinity = rangeInfinity(bounds[1]); r.UpperInfinity != 0 {
+		bounds[1] = nil
+	}
+	if err := scanTextField(&r.Lower, bounds[0]); err != nil {
+		return fmt.Errorf("Lower: %w", err)
+	}
+	if err := scanTextField(&r.Upper, bounds[1]); err != nil {
+		return fmt.Errorf("Upper: %w", err)
+	}
+	return nil
+}
+
+func (r PgTstzrange) Value() (driver.Value, error) {
+	return rangeLiteral(rangeBound(r.Lower, r.LowerInfinity), rangeBound(r.Upper, r.UpperInfinity), r.LowerInclusive, r.UpperInclusive, r.Empty)
+}
+
+// NullTstzrange is a PgTstzrange that may be NULL.
+type NullTstzrange struct {
+	Range PgTstzrange
+	Valid bool
+}
+
+func (n *NullTstzrange) Scan(src interface{}) error {
+	if src == nil {
+		*n = NullTstzrange{}
+		return nil
+	}
+	n.Valid = true
+	return n.Range.Scan(src)
+}
+
+func (n NullTstzrange) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Range.Value()
+}
+
+// PgInterval is a PostgreSQL interval. Months and days are kept apart from the
+// time of day because their length varies with the date they're added to.
+type PgInterval struct {
+	Months       int32
+	Days         int32
+	Microseconds int64
+}
+
+// Duration returns the interval as a time.Duration. It reports false if the
+// interval has months or days, which have no fixed length.
+func (i PgInterval) Duration() (time.Duration, bool) {
+	if i.Months != 0 || i.Days != 0 {
+		return 0, false
+	}
+	return time.Duration(i.Microseconds) * time.Microsecond, true
+}
+
+func (i *PgInterval) Scan(src interface{}) error {
+	var literal string
+	switch s := src.(type) {
+	case []byte:
+		literal = string(s)
+	case string:
+		literal = s
+	case nil:
+		*i = PgInterval{}
+		return nil
+	default:
+		return fmt.Errorf("unsupported scan type for PgInterval: %T", src)
+	}
+	v, err := pginterval.Parse(literal)
+	if err != nil {
+		return err
+	}
+	*i = PgInterval(v)
+	return nil
+}
+
+func (i PgInterval) Value() (driver.Value, error) {
+	return fmt.Sprintf("%d months %d days %d microseconds", i.Months, i.Days, i.Microseconds), nil
+}
+
+// NullInterval is an interval that may be NULL.
+type NullInterval struct {
+	Interval PgInterval
+	Valid    bool
+}
+
+func (n *NullInterval) Scan(src interface{}) error {
+	if src == nil {
+		*n = NullInterval{}
+		return nil
+	}
+	n.Valid = true
+	return n.Interval.Scan(src)
+}
+
+func (n NullInterval) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Interval.Value()
+}
+
+// parseRangeLiteral splits the text format of a range, such as "[1,10)", into
+// its bounds. A nil bound is unbounded; nil bounds mean the range is empty.
+func parseRangeLiteral(s string) ([]*string, bool, bool, error) {
+	if s == "empty" {
+		return nil, false, false, nil
+	}
+	if len(s) < 2 || (s[0] != '[' && s[0] != '(') || (s[len(s)-1] != ']' && s[len(s)-1] != ')') {
+		return nil, false, false, fmt.Errorf("invalid range literal: %q", s)
+	}
+	bounds := splitTextFields(s[1 : len(s)-1])
+	if len(bounds) != 2 {
+		return nil, false, false, fmt.Errorf("invalid range literal: %q", s)
+	}
+	return bounds, s[0] == '[', s[len(s)-1] == ']', nil
+}
+
+// rangeInfinity returns -1 if a bound is -infinity, 1 if it's infinity, and 0
+// otherwise.
+func rangeInfinity(bound *string) int {
+	switch {
+	case bound == nil:
+		return 0
+	case strings.EqualFold(*bound, "-infinity"):
+		return -1
+	case strings.EqualFold(*bound, "infinity"):
+		return 1
+	}
+	return 0
+}
+
+// rangeBound returns the bound of a range, or infinity or -infinity in its
+// place.
+func rangeBound(bound interface{}, infinity int) interface{} {
+	switch {
+	case infinity < 0:
+		return "-infinity"
+	case infinity > 0:
+		return "infinity"
+	}
+	return bound
+}
+
+// rangeLiteral returns the text format of a range. A NULL bound is unbounded.
+func rangeLiteral(lower, upper interface{}, lowerInclusive, upperInclusive, empty bool) (driver.Value, error) {
+	if empty {
+		return "empty", nil
+	}
+	var b strings.Builder
+	if lowerInclusive {
+		b.WriteByte('[')
+	} else {
+		b.WriteByte('(')
+	}
+	if err := writeTextField(&b, lower); err != nil {
+		return nil, err
+	}
+	b.WriteByte(',')
+	if err := writeTextField(&b, upper); err != nil {
+		return nil, err
+	}
+	if upperInclusive {
+		b.WriteByte(']')
+	} else {
+		b.WriteByte(')')
+	}
+	return b.String(), nil
+}
+
+// splitTextFields splits the fields of a composite value or the bounds of a
+// range, which are separated by commas and may be quoted. A nil field is NULL.
+func splitTextFields(s string) []*string {
+	var fields []*string
+	var b strings.Builder
+	quoted, present := false, false
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '\\' && i+1 < len(s):
+			i++
+			b.WriteByte(s[i])
+			present = true
+		case c == '"' && quoted && i+1 < len(s) && s[i+1] == '"':
+			i++
+			b.WriteByte('"')
+		case c == '"':
+			quoted = !quoted
+			present = true
+		case c == ',' && !quoted:
+			fields = append(fields, textField(&b, present))
+			present = false
+		default:
+			b.WriteByte(c)
+			present = true
+		}
+	}
+	return append(fields, textField(&b, present))
+}
+
+func textField(b *strings.Builder, present bool) *string {
+	if !present {
+		return nil
+	}
+	s := b.String()
+	b.Reset()
+	return &s
+}
+
+// scanTextField converts the text of a field into dst. NULL fields leave dst
+// unchanged.
+func scanTextField(dst interface{}, src *string) error {
+	if src == nil {
+		return nil
+	}
+	switch d := dst.(type) {
+	case *json.RawMessage:
+		*d = json.RawMessage(*src)
+		return nil
+	case *time.Time:
+		t, err := parseTextTime(*src)
+		*d = t
+		return err
+	case *sql.NullTime:
+		t, err := parseTextTime(*src)
+		*d = sql.NullTime{Time: t, Valid: err == nil}
+		return err
+	case sql.Scanner:
+		return d.Scan(*src)
+	}
+	v := reflect.ValueOf(dst).Elem()
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(*src)
+	case reflect.Slice:
+		if v.Type().Elem().Kind() != reflect.Uint8 {
+			return fmt.Errorf("unsupported field type %T", dst)
+		}
+		b, err := hex.DecodeString(strings.TrimPrefix(*src, "\\x"))
+		if err != nil {
+			return err
+		}
+		v.SetBytes(b)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(*src)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(*src, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(*src, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	case reflect.Interface:
+		v.Set(reflect.ValueOf(*src))
+	default:
+		return fmt.Errorf("unsupported field type %T", dst)
+	}
+	return nil
+}
+
+func parseTextTime(s string) (time.Time, error) {
+	for _, layout := range []string{
+		"2006-01-02 15:04:05.999999999Z07",
+		"2006-01-02 15:04:05.999999999Z07:00",
+		"2006-01-02 15:04:05.999999999",
+		"2006-01-02",
+		"15:04:05.999999999",
+	} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time: %q", s)
+}
+
+// writeTextField writes a quoted field of a composite value or a bound of a
+// range. NULL is written as nothing.
+func writeTextField(b *strings.Builder, field interface{}) error {
+	if v, ok := field.(driver.Valuer); ok {
+		val, err := v.Value()
+		if err != nil {
+			return err
+		}
+		field = val
+	}
+	var s string
+	switch f := field.(type) {
+	case nil:
+		return nil
+	case string:
+		s = f
+	case json.RawMessage:
+		if f == nil {
+			return nil
+		}
+		s = string(f)
+	case []byte:
+		s = "\\x" + hex.EncodeToString(f)
+	case time.Time:
+		s = f.Format("2006-01-02 15:04:05.999999999Z07:00")
+	default:
+		v := reflect.ValueOf(field)
+		switch {
+		case v.Kind() == reflect.String:
+			s = v.String()
+		case v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8:
+			s = "\\x" + hex.EncodeToString(v.Bytes())
+		default:
+			s = fmt.Sprint(field)
+		}
+	}
+	b.WriteByte('"')
+	b.WriteString(strings.NewReplacer("\\", "\\\\", "\"", "\\\"").Replace(s))
+	b.WriteByte('"')
+	return nil
+}
+
+type Interval struct {
+	ID    int32
+	Every PgInterval
+	Delay NullInterval
+}
+
+type Tstzrange struct {
+	ID       int32
+	During   PgTstzrange
+	Extended NullTstzrange
+}
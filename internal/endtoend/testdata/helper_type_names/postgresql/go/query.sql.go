@@ -0,0 +1,75 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+)
+
+const getDelay = `-- name: GetDelay :one
+SELECT delay FROM intervals
+WHERE id = $1
+`
+
+func (q *Queries) GetDelay(ctx context.Context, id int32) (NullInterval, error) {
+	row := q.db.QueryRowContext(ctx, getDelay, id)
+	var delay NullInterval
+	err := row.Scan(&delay)
+	return delay, err
+}
+
+const listIntervals = `-- name: ListIntervals :many
+SELECT id, every, delay FROM intervals
+`
+
+func (q *Queries) ListIntervals(ctx context.Context) ([]Interval, error) {
+	rows, err := q.db.QueryContext(ctx, listIntervals)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Interval
+	for rows.Next() {
+		var i Interval
+		if err := rows.Scan(&i.ID, &i.Every, &i.Delay); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTstzranges = `-- name: ListTstzranges :many
+SELECT id, during, extended FROM tstzranges
+WHERE during && $1
+`
+
+func (q *Queries) ListTstzranges(ctx context.Context, during PgTstzrange) ([]Tstzrange, error) {
+	rows, err := q.db.QueryContext(ctx, listTstzranges, during)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Tstzrange
+	for rows.Next() {
+		var i Tstzrange
+		if err := rows.Scan(&i.ID, &i.During, &i.Extended); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,10 @@
+-- name: ListIntervals :many
+SELECT * FROM intervals;
+
+-- name: GetDelay :one
+SELECT delay FROM intervals
+WHERE id = $1;
+
+-- name: ListTstzranges :many
+SELECT * FROM tstzranges
+WHERE during && $1;
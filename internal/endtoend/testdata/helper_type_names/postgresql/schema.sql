@@ -0,0 +1,11 @@
+CREATE TABLE intervals (
+    id       SERIAL PRIMARY KEY,
+    every    interval NOT NULL,
+    delay    interval
+);
+
+CREATE TABLE tstzranges (
+    id       SERIAL PRIMARY KEY,
+    during   tstzrange NOT NULL,
+    extended tstzrange
+);
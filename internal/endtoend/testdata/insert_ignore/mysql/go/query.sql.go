@@ -0,0 +1,60 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+)
+
+const insertSettingIgnore = `-- name: InsertSettingIgnore :execrows
+INSERT IGNORE INTO settings (name, value) VALUES (?, ?)
+`
+
+type InsertSettingIgnoreParams struct {
+	Name  string
+	Value string
+}
+
+func (q *Queries) InsertSettingIgnore(ctx context.Context, arg InsertSettingIgnoreParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, insertSettingIgnore, arg.Name, arg.Value)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const insertSettingIgnoreSet = `-- name: InsertSettingIgnoreSet :execrows
+INSERT IGNORE INTO settings SET name = ?, value = ?, note = ?
+`
+
+type InsertSettingIgnoreSetParams struct {
+	Name  string
+	Value string
+	Note  sql.NullString
+}
+
+func (q *Queries) InsertSettingIgnoreSet(ctx context.Context, arg InsertSettingIgnoreSetParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, insertSettingIgnoreSet, arg.Name, arg.Value, arg.Note)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const insertSettingIgnoreUpdate = `-- name: InsertSettingIgnoreUpdate :exec
+INSERT IGNORE INTO settings (name, value) VALUES (?, ?)
+ON DUPLICATE KEY UPDATE note = ?
+`
+
+type InsertSettingIgnoreUpdateParams struct {
+	Name  string
+	Value string
+	Note  sql.NullString
+}
+
+func (q *Queries) InsertSettingIgnoreUpdate(ctx context.Context, arg InsertSettingIgnoreUpdateParams) error {
+	_, err := q.db.ExecContext(ctx, insertSettingIgnoreUpdate, arg.Name, arg.Value, arg.Note)
+	return err
+}
@@ -0,0 +1,9 @@
+-- name: InsertSettingIgnore :execrows
+INSERT IGNORE INTO settings (name, value) VALUES (?, ?);
+
+-- name: InsertSettingIgnoreSet :execrows
+INSERT IGNORE INTO settings SET name = ?, value = ?, note = ?;
+
+-- name: InsertSettingIgnoreUpdate :exec
+INSERT IGNORE INTO settings (name, value) VALUES (?, ?)
+ON DUPLICATE KEY UPDATE note = ?;
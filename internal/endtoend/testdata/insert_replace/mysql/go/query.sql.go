@@ -0,0 +1,48 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+)
+
+const replaceSetting = `-- name: ReplaceSetting :exec
+REPLACE INTO settings (name, value, note) VALUES (?, ?, ?)
+`
+
+type ReplaceSettingParams struct {
+	Name  string
+	Value string
+	Note  sql.NullString
+}
+
+func (q *Queries) ReplaceSetting(ctx context.Context, arg ReplaceSettingParams) error {
+	_, err := q.db.ExecContext(ctx, replaceSetting, arg.Name, arg.Value, arg.Note)
+	return err
+}
+
+const replaceSettingSelect = `-- name: ReplaceSettingSelect :exec
+REPLACE INTO settings (name, value)
+SELECT CONCAT(name, '_copy'), value FROM settings WHERE name = ?
+`
+
+func (q *Queries) ReplaceSettingSelect(ctx context.Context, name string) error {
+	_, err := q.db.ExecContext(ctx, replaceSettingSelect, name)
+	return err
+}
+
+const replaceSettingSet = `-- name: ReplaceSettingSet :exec
+REPLACE INTO settings SET name = ?, value = ?
+`
+
+type ReplaceSettingSetParams struct {
+	Name  string
+	Value string
+}
+
+func (q *Queries) ReplaceSettingSet(ctx context.Context, arg ReplaceSettingSetParams) error {
+	_, err := q.db.ExecContext(ctx, replaceSettingSet, arg.Name, arg.Value)
+	return err
+}
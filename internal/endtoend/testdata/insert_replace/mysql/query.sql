@@ -0,0 +1,9 @@
+-- name: ReplaceSetting :exec
+REPLACE INTO settings (name, value, note) VALUES (?, ?, ?);
+
+-- name: ReplaceSettingSet :exec
+REPLACE INTO settings SET name = ?, value = ?;
+
+-- name: ReplaceSettingSelect :exec
+REPLACE INTO settings (name, value)
+SELECT CONCAT(name, '_copy'), value FROM settings WHERE name = ?;
@@ -0,0 +1,5 @@
+CREATE TABLE settings (
+    name  VARCHAR(255) NOT NULL PRIMARY KEY,
+    value TEXT NOT NULL,
+    note  TEXT
+);
@@ -0,0 +1,4 @@
+CREATE TABLE authors (id integer primary key, name text not null, bio text);
+
+-- name: CreateAuthor :exec
+INSERT INTO authors (name, bio) VALUES (?, upper(?));
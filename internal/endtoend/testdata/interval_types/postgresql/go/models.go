@@ -0,0 +1,81 @@
+// Code generated by sqlc. DO NOT EDIT.
+
+package querytest
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"time"
+
+	"github.com/kyleconroy/sqlc/pkg/pginterval"
+)
+
+// Interval is a PostgreSQL interval. Months and days are kept apart from the
+// time of day because their length varies with the date they're added to.
+type Interval struct {
+	Months       int32
+	Days         int32
+	Microseconds int64
+}
+
+// Duration returns the interval as a time.Duration. It reports false if the
+// interval has months or days, which have no fixed length.
+func (i Interval) Duration() (time.Duration, bool) {
+	if i.Months != 0 || i.Days != 0 {
+		return 0, false
+	}
+	return time.Duration(i.Microseconds) * time.Microsecond, true
+}
+
+func (i *Interval) Scan(src interface{}) error {
+	var literal string
+	switch s := src.(type) {
+	case []byte:
+		literal = string(s)
+	case string:
+		literal = s
+	case nil:
+		*i = Interval{}
+		return nil
+	default:
+		return fmt.Errorf("unsupported scan type for Interval: %T", src)
+	}
+	v, err := pginterval.Parse(literal)
+	if err != nil {
+		return err
+	}
+	*i = Interval(v)
+	return nil
+}
+
+func (i Interval) Value() (driver.Value, error) {
+	return fmt.Sprintf("%d months %d days %d microseconds", i.Months, i.Days, i.Microseconds), nil
+}
+
+// NullInterval is an interval that may be NULL.
+type NullInterval struct {
+	Interval Interval
+	Valid    bool
+}
+
+func (n *NullInterval) Scan(src interface{}) error {
+	if src == nil {
+		*n = NullInterval{}
+		return nil
+	}
+	n.Valid = true
+	return n.Interval.Scan(src)
+}
+
+func (n NullInterval) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Interval.Value()
+}
+
+type Task struct {
+	ID       int32
+	Estimate Interval
+	Spent    NullInterval
+}
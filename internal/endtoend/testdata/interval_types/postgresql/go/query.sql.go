@@ -0,0 +1,60 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+)
+
+const createTask = `-- name: CreateTask :exec
+INSERT INTO tasks (estimate, spent) VALUES ($1, $2)
+`
+
+type CreateTaskParams struct {
+	Estimate Interval
+	Spent    NullInterval
+}
+
+func (q *Queries) CreateTask(ctx context.Context, arg CreateTaskParams) error {
+	_, err := q.db.ExecContext(ctx, createTask, arg.Estimate, arg.Spent)
+	return err
+}
+
+const getRemaining = `-- name: GetRemaining :one
+SELECT estimate - spent AS remaining FROM tasks WHERE id = $1
+`
+
+func (q *Queries) GetRemaining(ctx context.Context, id int32) (NullInterval, error) {
+	row := q.db.QueryRowContext(ctx, getRemaining, id)
+	var remaining NullInterval
+	err := row.Scan(&remaining)
+	return remaining, err
+}
+
+const listTasks = `-- name: ListTasks :many
+SELECT id, estimate, spent FROM tasks
+`
+
+func (q *Queries) ListTasks(ctx context.Context) ([]Task, error) {
+	rows, err := q.db.QueryContext(ctx, listTasks)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Task
+	for rows.Next() {
+		var i Task
+		if err := rows.Scan(&i.ID, &i.Estimate, &i.Spent); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,21 @@
+// Code generated by sqlc. DO NOT EDIT.
+
+package com.example.querytest
+
+import java.sql.Connection
+import java.sql.SQLException
+import java.sql.Statement
+import org.postgresql.util.PGInterval
+
+interface Queries {
+  @Throws(SQLException::class)
+  fun createTask(estimate: PGInterval, spent: PGInterval?)
+  
+  @Throws(SQLException::class)
+  fun getRemaining(id: Int): PGInterval??
+  
+  @Throws(SQLException::class)
+  fun listTasks(): List<Task>
+  
+}
+
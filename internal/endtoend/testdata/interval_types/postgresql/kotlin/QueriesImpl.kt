@@ -0,0 +1,69 @@
+// Code generated by sqlc. DO NOT EDIT.
+
+package com.example.querytest
+
+import java.sql.Connection
+import java.sql.SQLException
+import java.sql.Statement
+import org.postgresql.util.PGInterval
+
+const val createTask = """-- name: createTask :exec
+INSERT INTO tasks (estimate, spent) VALUES (?, ?)
+"""
+
+const val getRemaining = """-- name: getRemaining :one
+SELECT estimate - spent AS remaining FROM tasks WHERE id = ?
+"""
+
+const val listTasks = """-- name: listTasks :many
+SELECT id, estimate, spent FROM tasks
+"""
+
+class QueriesImpl(private val conn: Connection) : Queries {
+
+  @Throws(SQLException::class)
+  override fun createTask(estimate: PGInterval, spent: PGInterval?) {
+    conn.prepareStatement(createTask).use { stmt ->
+      stmt.setObject(1, estimate)
+          stmt.setObject(2, spent)
+
+      stmt.execute()
+    }
+  }
+
+  @Throws(SQLException::class)
+  override fun getRemaining(id: Int): PGInterval?? {
+    return conn.prepareStatement(getRemaining).use { stmt ->
+      stmt.setInt(1, id)
+
+      val results = stmt.executeQuery()
+      if (!results.next()) {
+        return null
+      }
+      val ret = results.getObject(1, PGInterval::class.java)
+      if (results.next()) {
+          throw SQLException("expected one row in result set, but got many")
+      }
+      ret
+    }
+  }
+
+  @Throws(SQLException::class)
+  override fun listTasks(): List<Task> {
+    return conn.prepareStatement(listTasks).use { stmt ->
+      
+      val results = stmt.executeQuery()
+      val ret = mutableListOf<Task>()
+      while (results.next()) {
+          ret.add(Task(
+                results.getInt(1),
+                results.getObject(2, PGInterval::class.java),
+                results.getObject(3, PGInterval::class.java)
+            ))
+      }
+      ret
+    }
+  }
+
+}
+
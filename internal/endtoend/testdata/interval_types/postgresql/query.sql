@@ -0,0 +1,8 @@
+-- name: ListTasks :many
+SELECT * FROM tasks;
+
+-- name: CreateTask :exec
+INSERT INTO tasks (estimate, spent) VALUES ($1, $2);
+
+-- name: GetRemaining :one
+SELECT estimate - spent AS remaining FROM tasks WHERE id = $1;
@@ -0,0 +1,5 @@
+CREATE TABLE tasks (
+    id       SERIAL PRIMARY KEY,
+    estimate interval NOT NULL,
+    spent    interval
+);
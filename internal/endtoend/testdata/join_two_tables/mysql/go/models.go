@@ -5,14 +5,14 @@ package querytest
 import ()
 
 type Bar struct {
-	ID int64
+	ID uint64
 }
 
 type Baz struct {
-	ID int64
+	ID uint64
 }
 
 type Foo struct {
-	BarID int64
-	BazID int64
+	BarID uint64
+	BazID uint64
 }
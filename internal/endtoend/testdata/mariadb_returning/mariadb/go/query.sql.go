@@ -0,0 +1,105 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+)
+
+const createAuthor = `-- name: CreateAuthor :one
+INSERT INTO authors (name, bio) VALUES (?, ?)
+RETURNING id, name
+`
+
+type CreateAuthorParams struct {
+	Name string
+	Bio  sql.NullString
+}
+
+type CreateAuthorRow struct {
+	ID   int64
+	Name string
+}
+
+func (q *Queries) CreateAuthor(ctx context.Context, arg CreateAuthorParams) (CreateAuthorRow, error) {
+	row := q.db.QueryRowContext(ctx, createAuthor, arg.Name, arg.Bio)
+	var i CreateAuthorRow
+	err := row.Scan(&i.ID, &i.Name)
+	return i, err
+}
+
+const createAuthorReturningAll = `-- name: CreateAuthorReturningAll :one
+INSERT INTO authors (name, bio) VALUES (?, ?) RETURNING id, name, bio
+`
+
+type CreateAuthorReturningAllParams struct {
+	Name string
+	Bio  sql.NullString
+}
+
+func (q *Queries) CreateAuthorReturningAll(ctx context.Context, arg CreateAuthorReturningAllParams) (Author, error) {
+	row := q.db.QueryRowContext(ctx, createAuthorReturningAll, arg.Name, arg.Bio)
+	var i Author
+	err := row.Scan(&i.ID, &i.Name, &i.Bio)
+	return i, err
+}
+
+const deleteAuthor = `-- name: DeleteAuthor :exec
+DELETE FROM authors WHERE id = ?
+`
+
+func (q *Queries) DeleteAuthor(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, deleteAuthor, id)
+	return err
+}
+
+const deleteAuthors = `-- name: DeleteAuthors :many
+DELETE FROM authors WHERE name = ?
+RETURNING id, CONCAT(name, ' (deleted)') AS label
+`
+
+type DeleteAuthorsRow struct {
+	ID    int64
+	Label string
+}
+
+func (q *Queries) DeleteAuthors(ctx context.Context, name string) ([]DeleteAuthorsRow, error) {
+	rows, err := q.db.QueryContext(ctx, deleteAuthors, name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []DeleteAuthorsRow
+	for rows.Next() {
+		var i DeleteAuthorsRow
+		if err := rows.Scan(&i.ID, &i.Label); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const replaceAuthor = `-- name: ReplaceAuthor :one
+REPLACE INTO authors (id, name) VALUES (?, ?) RETURNING id
+`
+
+type ReplaceAuthorParams struct {
+	ID   int64
+	Name string
+}
+
+func (q *Queries) ReplaceAuthor(ctx context.Context, arg ReplaceAuthorParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, replaceAuthor, arg.ID, arg.Name)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
@@ -0,0 +1,16 @@
+-- name: CreateAuthor :one
+INSERT INTO authors (name, bio) VALUES (?, ?)
+RETURNING id, name;
+
+-- name: CreateAuthorReturningAll :one
+INSERT INTO authors (name, bio) VALUES (?, ?) RETURNING *;
+
+-- name: ReplaceAuthor :one
+REPLACE INTO authors (id, name) VALUES (?, ?) RETURNING id;
+
+-- name: DeleteAuthors :many
+DELETE FROM authors WHERE name = ?
+RETURNING id, CONCAT(name, ' (deleted)') AS label;
+
+-- name: DeleteAuthor :exec
+DELETE FROM authors WHERE id = ?;
@@ -0,0 +1,5 @@
+CREATE TABLE authors (
+    id   BIGINT PRIMARY KEY AUTO_INCREMENT,
+    name VARCHAR(255) NOT NULL,
+    bio  TEXT
+);
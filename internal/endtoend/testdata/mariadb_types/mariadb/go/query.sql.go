@@ -0,0 +1,125 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+)
+
+const createTicket = `-- name: CreateTicket :one
+INSERT INTO tickets (id, number, title, client) VALUES (UUID(), NEXTVAL(ticket_numbers), ?, ?)
+RETURNING id, number
+`
+
+type CreateTicketParams struct {
+	Title  string
+	Client sql.NullString
+}
+
+type CreateTicketRow struct {
+	ID     string
+	Number int64
+}
+
+func (q *Queries) CreateTicket(ctx context.Context, arg CreateTicketParams) (CreateTicketRow, error) {
+	row := q.db.QueryRowContext(ctx, createTicket, arg.Title, arg.Client)
+	var i CreateTicketRow
+	err := row.Scan(&i.ID, &i.Number)
+	return i, err
+}
+
+const createTicketReturningAll = `-- name: CreateTicketReturningAll :one
+INSERT INTO tickets (id, number, title) VALUES (?, NEXT VALUE FOR ticket_numbers, ?) RETURNING id, number, title, client, uuid, origin
+`
+
+type CreateTicketReturningAllParams struct {
+	ID    string
+	Title string
+}
+
+func (q *Queries) CreateTicketReturningAll(ctx context.Context, arg CreateTicketReturningAllParams) (Ticket, error) {
+	row := q.db.QueryRowContext(ctx, createTicketReturningAll, arg.ID, arg.Title)
+	var i Ticket
+	err := row.Scan(
+		&i.ID,
+		&i.Number,
+		&i.Title,
+		&i.Client,
+		&i.Uuid,
+		&i.Origin,
+	)
+	return i, err
+}
+
+const deleteTicket = `-- name: DeleteTicket :many
+DELETE FROM tickets WHERE title = ? RETURNING id, title AS deleted_title
+`
+
+type DeleteTicketRow struct {
+	ID           string
+	DeletedTitle string
+}
+
+func (q *Queries) DeleteTicket(ctx context.Context, title string) ([]DeleteTicketRow, error) {
+	rows, err := q.db.QueryContext(ctx, deleteTicket, title)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []DeleteTicketRow
+	for rows.Next() {
+		var i DeleteTicketRow
+		if err := rows.Scan(&i.ID, &i.DeletedTitle); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTicket = `-- name: GetTicket :one
+SELECT id, client, origin, uuid, SYS_GUID() AS guid FROM tickets WHERE id = ?
+`
+
+type GetTicketRow struct {
+	ID     string
+	Client sql.NullString
+	Origin sql.NullString
+	Uuid   sql.NullString
+	Guid   string
+}
+
+func (q *Queries) GetTicket(ctx context.Context, id string) (GetTicketRow, error) {
+	row := q.db.QueryRowContext(ctx, getTicket, id)
+	var i GetTicketRow
+	err := row.Scan(
+		&i.ID,
+		&i.Client,
+		&i.Origin,
+		&i.Uuid,
+		&i.Guid,
+	)
+	return i, err
+}
+
+const replaceTicket = `-- name: ReplaceTicket :exec
+REPLACE INTO tickets (id, number, title) VALUES (?, LASTVAL(ticket_numbers), ?)
+`
+
+type ReplaceTicketParams struct {
+	ID    string
+	Title string
+}
+
+func (q *Queries) ReplaceTicket(ctx context.Context, arg ReplaceTicketParams) error {
+	_, err := q.db.ExecContext(ctx, replaceTicket, arg.ID, arg.Title)
+	return err
+}
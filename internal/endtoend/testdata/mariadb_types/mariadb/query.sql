@@ -0,0 +1,15 @@
+-- name: CreateTicket :one
+INSERT INTO tickets (id, number, title, client) VALUES (UUID(), NEXTVAL(ticket_numbers), ?, ?)
+RETURNING id, number;
+
+-- name: CreateTicketReturningAll :one
+INSERT INTO tickets (id, number, title) VALUES (?, NEXT VALUE FOR ticket_numbers, ?) RETURNING *;
+
+-- name: DeleteTicket :many
+DELETE FROM tickets WHERE title = ? RETURNING id, title AS deleted_title;
+
+-- name: ReplaceTicket :exec
+REPLACE INTO tickets (id, number, title) VALUES (?, LASTVAL(ticket_numbers), ?);
+
+-- name: GetTicket :one
+SELECT id, client, origin, uuid, SYS_GUID() AS guid FROM tickets WHERE id = ?;
@@ -0,0 +1,11 @@
+CREATE SEQUENCE ticket_numbers START WITH 100 INCREMENT BY 1;
+
+CREATE TABLE tickets (
+    id      UUID NOT NULL PRIMARY KEY,
+    number  BIGINT NOT NULL,
+    title   VARCHAR(255) NOT NULL,
+    client  INET6,
+    uuid    UUID
+);
+
+ALTER TABLE tickets ADD COLUMN origin INET4;
@@ -8,4 +8,6 @@ ALTER TABLE authors ADD COLUMN gender INTEGER NULL;
 
 CREATE MATERIALIZED VIEW authors_names as SELECT name from authors;
 
+CREATE UNIQUE INDEX authors_names_name_idx ON authors_names (name);
+
 SELECT * FROM authors;
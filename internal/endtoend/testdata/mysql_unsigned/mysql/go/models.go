@@ -0,0 +1,75 @@
+// Code generated by sqlc. DO NOT EDIT.
+
+package querytest
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+
+	"github.com/kyleconroy/sqlc-testdata/pkg"
+)
+
+// NullUint64 is a uint64 that may be NULL, such as the value of a nullable
+// BIGINT UNSIGNED column.
+type NullUint64 struct {
+	Uint64 uint64
+	Valid  bool
+}
+
+func (n *NullUint64) Scan(src interface{}) error {
+	var err error
+	switch v := src.(type) {
+	case nil:
+		*n = NullUint64{}
+		return nil
+	case uint64:
+		n.Uint64 = v
+	case int64:
+		if v < 0 {
+			return fmt.Errorf("NullUint64: %d is negative", v)
+		}
+		n.Uint64 = uint64(v)
+	case []byte:
+		n.Uint64, err = strconv.ParseUint(string(v), 10, 64)
+	case string:
+		n.Uint64, err = strconv.ParseUint(v, 10, 64)
+	default:
+		return fmt.Errorf("unsupported scan type for NullUint64: %T", src)
+	}
+	n.Valid = err == nil
+	return err
+}
+
+func (n NullUint64) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	// Values with the high bit set can't be passed as an int64
+	if n.Uint64 >= 1<<63 {
+		return strconv.FormatUint(n.Uint64, 10), nil
+	}
+	return int64(n.Uint64), nil
+}
+
+type Number struct {
+	ID           uint64
+	Flag         bool
+	MaybeFlag    sql.NullBool
+	SmallFlag    int32
+	UTiny        uint8
+	USmall       uint16
+	UMedium      uint32
+	UInt         pkg.CustomType
+	SignedInt    int32
+	UBig         uint64
+	SignedBig    pkg.CustomType
+	MaybeUTiny   sql.NullInt32
+	MaybeUMedium sql.NullInt64
+	MaybeUBig    NullUint64
+	Ratio        float32
+	MaybeRatio   sql.NullFloat64
+	Bits         []byte
+	Released     int32
+}
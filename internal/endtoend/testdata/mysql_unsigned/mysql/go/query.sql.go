@@ -0,0 +1,86 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+)
+
+const getNumbers = `-- name: GetNumbers :one
+SELECT id, flag, maybe_flag, small_flag, u_tiny, u_small, u_medium, u_int, signed_int, u_big, signed_big, maybe_u_tiny, maybe_u_medium, maybe_u_big, ratio, maybe_ratio, bits, released FROM numbers WHERE id = ?
+`
+
+func (q *Queries) GetNumbers(ctx context.Context, id uint64) (Number, error) {
+	row := q.db.QueryRowContext(ctx, getNumbers, id)
+	var i Number
+	err := row.Scan(
+		&i.ID,
+		&i.Flag,
+		&i.MaybeFlag,
+		&i.SmallFlag,
+		&i.UTiny,
+		&i.USmall,
+		&i.UMedium,
+		&i.UInt,
+		&i.SignedInt,
+		&i.UBig,
+		&i.SignedBig,
+		&i.MaybeUTiny,
+		&i.MaybeUMedium,
+		&i.MaybeUBig,
+		&i.Ratio,
+		&i.MaybeRatio,
+		&i.Bits,
+		&i.Released,
+	)
+	return i, err
+}
+
+const listByBig = `-- name: ListByBig :many
+SELECT id, u_big, maybe_u_big FROM numbers WHERE u_big > ?
+`
+
+type ListByBigRow struct {
+	ID        uint64
+	UBig      uint64
+	MaybeUBig NullUint64
+}
+
+func (q *Queries) ListByBig(ctx context.Context, uBig uint64) ([]ListByBigRow, error) {
+	rows, err := q.db.QueryContext(ctx, listByBig, uBig)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListByBigRow
+	for rows.Next() {
+		var i ListByBigRow
+		if err := rows.Scan(&i.ID, &i.UBig, &i.MaybeUBig); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setFlag = `-- name: SetFlag :exec
+UPDATE numbers SET flag = ?, maybe_u_big = ? WHERE id = ?
+`
+
+type SetFlagParams struct {
+	Flag      bool
+	MaybeUBig NullUint64
+	ID        uint64
+}
+
+func (q *Queries) SetFlag(ctx context.Context, arg SetFlagParams) error {
+	_, err := q.db.ExecContext(ctx, setFlag, arg.Flag, arg.MaybeUBig, arg.ID)
+	return err
+}
@@ -0,0 +1,8 @@
+/* name: GetNumbers :one */
+SELECT * FROM numbers WHERE id = ?;
+
+/* name: ListByBig :many */
+SELECT id, u_big, maybe_u_big FROM numbers WHERE u_big > ?;
+
+/* name: SetFlag :exec */
+UPDATE numbers SET flag = ?, maybe_u_big = ? WHERE id = ?;
@@ -0,0 +1,20 @@
+CREATE TABLE numbers (
+    id                  serial,
+    flag                tinyint(1) NOT NULL,
+    maybe_flag          bool,
+    small_flag          tinyint NOT NULL,
+    u_tiny              tinyint unsigned NOT NULL,
+    u_small             smallint unsigned NOT NULL,
+    u_medium            mediumint unsigned NOT NULL,
+    u_int               int unsigned NOT NULL,
+    signed_int          int NOT NULL,
+    u_big               bigint unsigned NOT NULL,
+    signed_big          bigint NOT NULL,
+    maybe_u_tiny        tinyint unsigned,
+    maybe_u_medium      mediumint unsigned,
+    maybe_u_big         bigint unsigned,
+    ratio               float NOT NULL,
+    maybe_ratio         float,
+    bits                bit(8) NOT NULL,
+    released            year NOT NULL
+);
@@ -0,0 +1,130 @@
+// Code generated by sqlc. DO NOT EDIT.
+
+package querytest
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+
+	"github.com/kyleconroy/sqlc-testdata/pkg"
+)
+
+type AppUsersRoles string
+
+const (
+	AppUsersRolesAdmin  AppUsersRoles = "admin"
+	AppUsersRolesEditor AppUsersRoles = "editor"
+	AppUsersRolesViewer AppUsersRoles = "viewer"
+)
+
+func (e *AppUsersRoles) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = AppUsersRoles(s)
+	case string:
+		*e = AppUsersRoles(s)
+	default:
+		return fmt.Errorf("unsupported scan type for AppUsersRoles: %T", src)
+	}
+	return nil
+}
+
+type AppUsersRolesSet []AppUsersRoles
+
+func (s *AppUsersRolesSet) Scan(src interface{}) error {
+	var list string
+	switch v := src.(type) {
+	case []byte:
+		list = string(v)
+	case string:
+		list = v
+	case nil:
+		*s = nil
+		return nil
+	default:
+		return fmt.Errorf("unsupported scan type for AppUsersRolesSet: %T", src)
+	}
+	*s = AppUsersRolesSet{}
+	if list == "" {
+		return nil
+	}
+	for _, v := range strings.Split(list, ",") {
+		*s = append(*s, AppUsersRoles(v))
+	}
+	return nil
+}
+
+func (s AppUsersRolesSet) Value() (driver.Value, error) {
+	vals := make([]string, len(s))
+	for i := range s {
+		vals[i] = string(s[i])
+	}
+	return strings.Join(vals, ","), nil
+}
+
+type AppUsersStatus string
+
+const (
+	AppUsersStatusInvited AppUsersStatus = "invited"
+	AppUsersStatusJoined  AppUsersStatus = "joined"
+)
+
+func (e *AppUsersStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = AppUsersStatus(s)
+	case string:
+		*e = AppUsersStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for AppUsersStatus: %T", src)
+	}
+	return nil
+}
+
+type UsersStatus string
+
+const (
+	UsersStatusActive UsersStatus = "active"
+	UsersStatusBanned UsersStatus = "banned"
+)
+
+func (e *UsersStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = UsersStatus(s)
+	case string:
+		*e = UsersStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for UsersStatus: %T", src)
+	}
+	return nil
+}
+
+type Account struct {
+	ID     uint64
+	UserID uint64
+}
+
+type AnalyticsEvent struct {
+	ID     uint64
+	UserID uint64
+	Name   string
+}
+
+type AnalyticsUser struct {
+	ID     uint64
+	Visits int32
+}
+
+type AppUser struct {
+	ID     uint64
+	Status AppUsersStatus
+	Roles  AppUsersRolesSet
+}
+
+type User struct {
+	ID     uint64
+	Name   pkg.CustomType
+	Status UsersStatus
+}
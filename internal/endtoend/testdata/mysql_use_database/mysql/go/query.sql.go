@@ -0,0 +1,248 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+
+	"github.com/kyleconroy/sqlc-testdata/pkg"
+)
+
+const createAppUser = `-- name: CreateAppUser :exec
+INSERT INTO app.users (status, roles) VALUES (?, ?)
+`
+
+type CreateAppUserParams struct {
+	Status AppUsersStatus
+	Roles  AppUsersRolesSet
+}
+
+func (q *Queries) CreateAppUser(ctx context.Context, arg CreateAppUserParams) error {
+	_, err := q.db.ExecContext(ctx, createAppUser, arg.Status, arg.Roles)
+	return err
+}
+
+const createEvent = `-- name: CreateEvent :exec
+INSERT INTO analytics.events (user_id, name) VALUES (?, ?)
+`
+
+type CreateEventParams struct {
+	UserID uint64
+	Name   string
+}
+
+func (q *Queries) CreateEvent(ctx context.Context, arg CreateEventParams) error {
+	_, err := q.db.ExecContext(ctx, createEvent, arg.UserID, arg.Name)
+	return err
+}
+
+const deleteEvent = `-- name: DeleteEvent :exec
+DELETE FROM analytics.events WHERE analytics.events.id = ?
+`
+
+func (q *Queries) DeleteEvent(ctx context.Context, id uint64) error {
+	_, err := q.db.ExecContext(ctx, deleteEvent, id)
+	return err
+}
+
+const getAppUser = `-- name: GetAppUser :one
+SELECT app.users.id, app.users.status, app.users.roles FROM app.users WHERE app.users.status = ?
+`
+
+func (q *Queries) GetAppUser(ctx context.Context, status AppUsersStatus) (AppUser, error) {
+	row := q.db.QueryRowContext(ctx, getAppUser, status)
+	var i AppUser
+	err := row.Scan(&i.ID, &i.Status, &i.Roles)
+	return i, err
+}
+
+const getUser = `-- name: GetUser :one
+SELECT id, name, status FROM users WHERE id = ?
+`
+
+func (q *Queries) GetUser(ctx context.Context, id uint64) (User, error) {
+	row := q.db.QueryRowContext(ctx, getUser, id)
+	var i User
+	err := row.Scan(&i.ID, &i.Name, &i.Status)
+	return i, err
+}
+
+const listAccounts = `-- name: ListAccounts :many
+SELECT accounts.id, users.name
+FROM accounts
+JOIN users ON users.id = accounts.user_id
+`
+
+type ListAccountsRow struct {
+	ID   uint64
+	Name pkg.CustomType
+}
+
+func (q *Queries) ListAccounts(ctx context.Context) ([]ListAccountsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listAccounts)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAccountsRow
+	for rows.Next() {
+		var i ListAccountsRow
+		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listBanned = `-- name: ListBanned :many
+SELECT id, name FROM users WHERE status = 'banned'
+`
+
+type ListBannedRow struct {
+	ID   uint64
+	Name pkg.CustomType
+}
+
+func (q *Queries) ListBanned(ctx context.Context) ([]ListBannedRow, error) {
+	rows, err := q.db.QueryContext(ctx, listBanned)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListBannedRow
+	for rows.Next() {
+		var i ListBannedRow
+		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEvents = `-- name: ListEvents :many
+SELECT id, user_id, name FROM analytics.events WHERE user_id = ?
+`
+
+func (q *Queries) ListEvents(ctx context.Context, userID uint64) ([]AnalyticsEvent, error) {
+	rows, err := q.db.QueryContext(ctx, listEvents, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AnalyticsEvent
+	for rows.Next() {
+		var i AnalyticsEvent
+		if err := rows.Scan(&i.ID, &i.UserID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserEvents = `-- name: ListUserEvents :many
+SELECT users.name, e.name AS event_name
+FROM users
+JOIN analytics.events e ON e.user_id = users.id
+WHERE e.user_id = ?
+`
+
+type ListUserEventsRow struct {
+	Name      pkg.CustomType
+	EventName string
+}
+
+func (q *Queries) ListUserEvents(ctx context.Context, userID uint64) ([]ListUserEventsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listUserEvents, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListUserEventsRow
+	for rows.Next() {
+		var i ListUserEventsRow
+		if err := rows.Scan(&i.Name, &i.EventName); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listVisits = `-- name: ListVisits :many
+SELECT u.name, app.users.status AS app_status, analytics.users.visits
+FROM users u
+JOIN app.users ON app.users.id = u.id
+JOIN analytics.users ON analytics.users.id = u.id
+WHERE analytics.users.visits > ?
+`
+
+type ListVisitsRow struct {
+	Name      pkg.CustomType
+	AppStatus AppUsersStatus
+	Visits    int32
+}
+
+func (q *Queries) ListVisits(ctx context.Context, visits int32) ([]ListVisitsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listVisits, visits)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListVisitsRow
+	for rows.Next() {
+		var i ListVisitsRow
+		if err := rows.Scan(&i.Name, &i.AppStatus, &i.Visits); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const renameEvent = `-- name: RenameEvent :exec
+UPDATE analytics.events SET name = ? WHERE id = ?
+`
+
+type RenameEventParams struct {
+	Name string
+	ID   uint64
+}
+
+func (q *Queries) RenameEvent(ctx context.Context, arg RenameEventParams) error {
+	_, err := q.db.ExecContext(ctx, renameEvent, arg.Name, arg.ID)
+	return err
+}
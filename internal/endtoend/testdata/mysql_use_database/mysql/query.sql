@@ -0,0 +1,41 @@
+/* name: GetUser :one */
+SELECT * FROM users WHERE id = ?;
+
+/* name: ListBanned :many */
+SELECT id, name FROM users WHERE status = 'banned';
+
+/* name: ListEvents :many */
+SELECT * FROM analytics.events WHERE user_id = ?;
+
+/* name: ListUserEvents :many */
+SELECT users.name, e.name AS event_name
+FROM users
+JOIN analytics.events e ON e.user_id = users.id
+WHERE e.user_id = ?;
+
+/* name: ListAccounts :many */
+SELECT accounts.id, users.name
+FROM accounts
+JOIN users ON users.id = accounts.user_id;
+
+/* name: CreateEvent :exec */
+INSERT INTO analytics.events (user_id, name) VALUES (?, ?);
+
+/* name: RenameEvent :exec */
+UPDATE analytics.events SET name = ? WHERE id = ?;
+
+/* name: DeleteEvent :exec */
+DELETE FROM analytics.events WHERE analytics.events.id = ?;
+
+/* name: GetAppUser :one */
+SELECT app.users.id, app.users.status, app.users.roles FROM app.users WHERE app.users.status = ?;
+
+/* name: CreateAppUser :exec */
+INSERT INTO app.users (status, roles) VALUES (?, ?);
+
+/* name: ListVisits :many */
+SELECT u.name, app.users.status AS app_status, analytics.users.visits
+FROM users u
+JOIN app.users ON app.users.id = u.id
+JOIN analytics.users ON analytics.users.id = u.id
+WHERE analytics.users.visits > ?;
@@ -0,0 +1,32 @@
+CREATE DATABASE IF NOT EXISTS app;
+CREATE DATABASE IF NOT EXISTS app;
+CREATE DATABASE analytics;
+DROP DATABASE IF EXISTS missing;
+
+-- Tables created before any USE are in the database queries connect to
+CREATE TABLE users (
+    id     serial,
+    name   text NOT NULL,
+    status ENUM('active', 'banned') NOT NULL
+);
+
+USE analytics;
+
+CREATE TABLE events (
+    id      serial,
+    user_id bigint unsigned NOT NULL,
+    name    text NOT NULL
+);
+
+USE app;
+
+CREATE TABLE users (
+    id     serial,
+    status ENUM('invited', 'joined') NOT NULL,
+    roles  SET('admin', 'editor') NOT NULL
+);
+
+CREATE TABLE analytics.users (
+    id     serial,
+    visits int NOT NULL
+);
@@ -0,0 +1,8 @@
+-- A USE in an earlier file doesn't carry over
+CREATE TABLE accounts (
+    id      serial,
+    user_id bigint unsigned NOT NULL
+);
+
+ALTER TABLE app.users DROP COLUMN roles;
+ALTER TABLE app.users ADD COLUMN roles SET('admin', 'editor', 'viewer') NOT NULL;
@@ -0,0 +1,5 @@
+/* name: GetUser :one */
+SELECT id, name FROM users WHERE id = ?;
+
+/* name: CountUsers :one */
+SELECT COUNT(*) FROM users
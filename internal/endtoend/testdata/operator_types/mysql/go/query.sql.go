@@ -0,0 +1,146 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+)
+
+const bitOperators = `-- name: BitOperators :many
+SELECT quantity ^ 1 AS flipped, quantity & 2 AS masked, quantity > 1 XOR discount > 1 AS either FROM items
+`
+
+type BitOperatorsRow struct {
+	Flipped int64
+	Masked  int64
+	Either  sql.NullBool
+}
+
+func (q *Queries) BitOperators(ctx context.Context) ([]BitOperatorsRow, error) {
+	rows, err := q.db.QueryContext(ctx, bitOperators)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []BitOperatorsRow
+	for rows.Next() {
+		var i BitOperatorsRow
+		if err := rows.Scan(&i.Flipped, &i.Masked, &i.Either); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const comparisons = `-- name: Comparisons :many
+SELECT quantity > 10 AS many, quantity - discount AS net FROM items
+`
+
+type ComparisonsRow struct {
+	Many bool
+	Net  sql.NullInt64
+}
+
+func (q *Queries) Comparisons(ctx context.Context) ([]ComparisonsRow, error) {
+	rows, err := q.db.QueryContext(ctx, comparisons)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ComparisonsRow
+	for rows.Next() {
+		var i ComparisonsRow
+		if err := rows.Scan(&i.Many, &i.Net); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const nullOperands = `-- name: NullOperands :many
+SELECT id + NULL AS unknown, quantity + (SELECT MAX(quantity) FROM items) AS most, quantity + ? AS more FROM items
+`
+
+type NullOperandsRow struct {
+	Unknown sql.NullInt64
+	Most    sql.NullInt64
+	More    int64
+}
+
+func (q *Queries) NullOperands(ctx context.Context, quantity int32) ([]NullOperandsRow, error) {
+	rows, err := q.db.QueryContext(ctx, nullOperands, quantity)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []NullOperandsRow
+	for rows.Next() {
+		var i NullOperandsRow
+		if err := rows.Scan(&i.Unknown, &i.Most, &i.More); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const totals = `-- name: Totals :many
+SELECT price * quantity AS total, quantity + 1 AS next, quantity / 2 AS half, weight * 2 AS doubled FROM items
+`
+
+type TotalsRow struct {
+	Total   string
+	Next    int64
+	Half    string
+	Doubled float64
+}
+
+func (q *Queries) Totals(ctx context.Context) ([]TotalsRow, error) {
+	rows, err := q.db.QueryContext(ctx, totals)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TotalsRow
+	for rows.Next() {
+		var i TotalsRow
+		if err := rows.Scan(
+			&i.Total,
+			&i.Next,
+			&i.Half,
+			&i.Doubled,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
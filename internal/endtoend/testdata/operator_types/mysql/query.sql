@@ -0,0 +1,19 @@
+CREATE TABLE items (
+    id       INT PRIMARY KEY AUTO_INCREMENT,
+    price    DECIMAL(10, 2) NOT NULL,
+    quantity INT NOT NULL,
+    weight   DOUBLE NOT NULL,
+    discount INT
+);
+
+-- name: Totals :many
+SELECT price * quantity AS total, quantity + 1 AS next, quantity / 2 AS half, weight * 2 AS doubled FROM items;
+
+-- name: Comparisons :many
+SELECT quantity > 10 AS many, quantity - discount AS net FROM items;
+
+-- name: NullOperands :many
+SELECT id + NULL AS unknown, quantity + (SELECT MAX(quantity) FROM items) AS most, quantity + ? AS more FROM items;
+
+-- name: BitOperators :many
+SELECT quantity ^ 1 AS flipped, quantity & 2 AS masked, quantity > 1 XOR discount > 1 AS either FROM items;
@@ -0,0 +1,273 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const comparisons = `-- name: Comparisons :many
+SELECT quantity > 10 AS many, name LIKE 'a%' AS starts, sku IS NOT DISTINCT FROM name AS same FROM items
+`
+
+type ComparisonsRow struct {
+	Many   bool
+	Starts bool
+	Same   bool
+}
+
+func (q *Queries) Comparisons(ctx context.Context) ([]ComparisonsRow, error) {
+	rows, err := q.db.QueryContext(ctx, comparisons)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ComparisonsRow
+	for rows.Next() {
+		var i ComparisonsRow
+		if err := rows.Scan(&i.Many, &i.Starts, &i.Same); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const dates = `-- name: Dates :many
+SELECT created_at + interval '1 day' AS tomorrow, now() - created_at AS age FROM items
+`
+
+type DatesRow struct {
+	Tomorrow time.Time
+	Age      Interval
+}
+
+func (q *Queries) Dates(ctx context.Context) ([]DatesRow, error) {
+	rows, err := q.db.QueryContext(ctx, dates)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []DatesRow
+	for rows.Next() {
+		var i DatesRow
+		if err := rows.Scan(&i.Tomorrow, &i.Age); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const jSONFields = `-- name: JSONFields :many
+SELECT data->>'color' AS color, data->'size' AS size, data @> '{"sale": true}' AS on_sale FROM items
+`
+
+type JSONFieldsRow struct {
+	Color  string
+	Size   json.RawMessage
+	OnSale bool
+}
+
+func (q *Queries) JSONFields(ctx context.Context) ([]JSONFieldsRow, error) {
+	rows, err := q.db.QueryContext(ctx, jSONFields)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []JSONFieldsRow
+	for rows.Next() {
+		var i JSONFieldsRow
+		if err := rows.Scan(&i.Color, &i.Size, &i.OnSale); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const labels = `-- name: Labels :many
+SELECT name || ' ' || sku AS label, name || id AS name_id FROM items
+`
+
+type LabelsRow struct {
+	Label  sql.NullString
+	NameID string
+}
+
+func (q *Queries) Labels(ctx context.Context) ([]LabelsRow, error) {
+	rows, err := q.db.QueryContext(ctx, labels)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []LabelsRow
+	for rows.Next() {
+		var i LabelsRow
+		if err := rows.Scan(&i.Label, &i.NameID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const nullOperands = `-- name: NullOperands :many
+SELECT quantity + NULL AS unknown, quantity + (SELECT MAX(quantity) FROM items) AS most FROM items
+`
+
+type NullOperandsRow struct {
+	Unknown sql.NullInt32
+	Most    sql.NullInt32
+}
+
+func (q *Queries) NullOperands(ctx context.Context) ([]NullOperandsRow, error) {
+	rows, err := q.db.QueryContext(ctx, nullOperands)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []NullOperandsRow
+	for rows.Next() {
+		var i NullOperandsRow
+		if err := rows.Scan(&i.Unknown, &i.Most); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const nullablePredicates = `-- name: NullablePredicates :many
+SELECT sku LIKE 'a%' AS sku_starts, sku IN ('a', 'b') AS sku_in, quantity BETWEEN 1 AND 10 AS few FROM items
+`
+
+type NullablePredicatesRow struct {
+	SkuStarts sql.NullBool
+	SkuIn     sql.NullBool
+	Few       bool
+}
+
+func (q *Queries) NullablePredicates(ctx context.Context) ([]NullablePredicatesRow, error) {
+	rows, err := q.db.QueryContext(ctx, nullablePredicates)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []NullablePredicatesRow
+	for rows.Next() {
+		var i NullablePredicatesRow
+		if err := rows.Scan(&i.SkuStarts, &i.SkuIn, &i.Few); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const tags = `-- name: Tags :many
+SELECT tags || ARRAY['new']::text[] AS tags, tags @> $1::text[] AS has FROM items
+`
+
+type TagsRow struct {
+	Tags []string
+	Has  bool
+}
+
+func (q *Queries) Tags(ctx context.Context, dollar_1 []string) ([]TagsRow, error) {
+	rows, err := q.db.QueryContext(ctx, tags, pq.Array(dollar_1))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TagsRow
+	for rows.Next() {
+		var i TagsRow
+		if err := rows.Scan(pq.Array(&i.Tags), &i.Has); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const totals = `-- name: Totals :many
+SELECT price * quantity AS total, quantity + 1 AS next, -weight AS negated FROM items
+`
+
+type TotalsRow struct {
+	Total   string
+	Next    int32
+	Negated float32
+}
+
+func (q *Queries) Totals(ctx context.Context) ([]TotalsRow, error) {
+	rows, err := q.db.QueryContext(ctx, totals)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TotalsRow
+	for rows.Next() {
+		var i TotalsRow
+		if err := rows.Scan(&i.Total, &i.Next, &i.Negated); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,35 @@
+CREATE TABLE items (
+    id         SERIAL PRIMARY KEY,
+    name       TEXT NOT NULL,
+    sku        TEXT,
+    price      NUMERIC NOT NULL,
+    quantity   INTEGER NOT NULL,
+    weight     REAL NOT NULL,
+    tags       TEXT[] NOT NULL,
+    data       JSONB NOT NULL,
+    created_at TIMESTAMP NOT NULL
+);
+
+-- name: Totals :many
+SELECT price * quantity AS total, quantity + 1 AS next, -weight AS negated FROM items;
+
+-- name: Labels :many
+SELECT name || ' ' || sku AS label, name || id AS name_id FROM items;
+
+-- name: Comparisons :many
+SELECT quantity > 10 AS many, name LIKE 'a%' AS starts, sku IS NOT DISTINCT FROM name AS same FROM items;
+
+-- name: NullablePredicates :many
+SELECT sku LIKE 'a%' AS sku_starts, sku IN ('a', 'b') AS sku_in, quantity BETWEEN 1 AND 10 AS few FROM items;
+
+-- name: JSONFields :many
+SELECT data->>'color' AS color, data->'size' AS size, data @> '{"sale": true}' AS on_sale FROM items;
+
+-- name: Dates :many
+SELECT created_at + interval '1 day' AS tomorrow, now() - created_at AS age FROM items;
+
+-- name: Tags :many
+SELECT tags || ARRAY['new']::text[] AS tags, tags @> $1::text[] AS has FROM items;
+
+-- name: NullOperands :many
+SELECT quantity + NULL AS unknown, quantity + (SELECT MAX(quantity) FROM items) AS most FROM items;
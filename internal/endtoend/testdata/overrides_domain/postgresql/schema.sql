@@ -0,0 +1,6 @@
+CREATE DOMAIN email AS text;
+CREATE TABLE users (
+    id SERIAL PRIMARY KEY,
+    email email NOT NULL,
+    name text NOT NULL
+);
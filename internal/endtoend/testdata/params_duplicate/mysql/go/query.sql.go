@@ -13,13 +13,8 @@ SELECT first_name from
 users where (? = id OR ? = 0)
 `
 
-type SelectUserByIDParams struct {
-	Column1 interface{}
-	ID      interface{}
-}
-
-func (q *Queries) SelectUserByID(ctx context.Context, arg SelectUserByIDParams) ([]sql.NullString, error) {
-	rows, err := q.db.QueryContext(ctx, selectUserByID, arg.Column1, arg.ID)
+func (q *Queries) SelectUserByID(ctx context.Context, id interface{}) ([]sql.NullString, error) {
+	rows, err := q.db.QueryContext(ctx, selectUserByID, id, id)
 	if err != nil {
 		return nil, err
 	}
@@ -48,13 +43,8 @@ WHERE first_name = ?
    OR last_name = ?
 `
 
-type SelectUserByNameParams struct {
-	FirstName sql.NullString
-	Name      sql.NullString
-}
-
-func (q *Queries) SelectUserByName(ctx context.Context, arg SelectUserByNameParams) ([]sql.NullString, error) {
-	rows, err := q.db.QueryContext(ctx, selectUserByName, arg.FirstName, arg.Name)
+func (q *Queries) SelectUserByName(ctx context.Context, name sql.NullString) ([]sql.NullString, error) {
+	rows, err := q.db.QueryContext(ctx, selectUserByName, name, name)
 	if err != nil {
 		return nil, err
 	}
@@ -108,3 +98,23 @@ func (q *Queries) SelectUserQuestion(ctx context.Context, arg SelectUserQuestion
 	}
 	return items, nil
 }
+
+const updateUserName = `-- name: UpdateUserName :exec
+UPDATE users SET first_name = ?, last_name = ?
+WHERE id = ? AND first_name <> ?
+`
+
+type UpdateUserNameParams struct {
+	Name sql.NullString
+	ID   int32
+}
+
+func (q *Queries) UpdateUserName(ctx context.Context, arg UpdateUserNameParams) error {
+	_, err := q.db.ExecContext(ctx, updateUserName,
+		arg.Name,
+		arg.Name,
+		arg.ID,
+		arg.Name,
+	)
+	return err
+}
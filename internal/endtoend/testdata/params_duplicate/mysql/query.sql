@@ -11,3 +11,7 @@ WHERE first_name = sqlc.arg(name)
 /* name: SelectUserQuestion :many */
 SELECT first_name from
 users where (? = id OR  ? = 0);
+
+/* name: UpdateUserName :exec */
+UPDATE users SET first_name = sqlc.arg(name), last_name = sqlc.arg(name)
+WHERE id = sqlc.arg(id) AND first_name <> sqlc.arg(name);
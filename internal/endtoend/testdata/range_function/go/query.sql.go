@@ -0,0 +1,198 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/lib/pq"
+)
+
+const lateralSubquery = `-- name: LateralSubquery :many
+SELECT u.id, latest.tag
+FROM users u
+CROSS JOIN LATERAL (
+    SELECT t.tag FROM unnest(u.tags) AS t(tag) WHERE u.id > 0 LIMIT 1
+) AS latest
+`
+
+type LateralSubqueryRow struct {
+	ID  int32
+	Tag string
+}
+
+func (q *Queries) LateralSubquery(ctx context.Context) ([]LateralSubqueryRow, error) {
+	rows, err := q.db.QueryContext(ctx, lateralSubquery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []LateralSubqueryRow
+	for rows.Next() {
+		var i LateralSubqueryRow
+		if err := rows.Scan(&i.ID, &i.Tag); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lateralTags = `-- name: LateralTags :many
+SELECT users.id, tag FROM users, LATERAL unnest(users.tags) AS tag
+`
+
+type LateralTagsRow struct {
+	ID  int32
+	Tag string
+}
+
+func (q *Queries) LateralTags(ctx context.Context) ([]LateralTagsRow, error) {
+	rows, err := q.db.QueryContext(ctx, lateralTags)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []LateralTagsRow
+	for rows.Next() {
+		var i LateralTagsRow
+		if err := rows.Scan(&i.ID, &i.Tag); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const recordSet = `-- name: RecordSet :many
+SELECT x.a, x.b FROM json_to_recordset($1::json) AS x(a int, b text)
+`
+
+type RecordSetRow struct {
+	A sql.NullInt32
+	B sql.NullString
+}
+
+func (q *Queries) RecordSet(ctx context.Context, dollar_1 json.RawMessage) ([]RecordSetRow, error) {
+	rows, err := q.db.QueryContext(ctx, recordSet, dollar_1)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RecordSetRow
+	for rows.Next() {
+		var i RecordSetRow
+		if err := rows.Scan(&i.A, &i.B); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setofTable = `-- name: SetofTable :many
+SELECT id, tags FROM list_users($1)
+`
+
+func (q *Queries) SetofTable(ctx context.Context, minID int32) ([]User, error) {
+	rows, err := q.db.QueryContext(ctx, setofTable, minID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []User
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(&i.ID, pq.Array(&i.Tags)); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const unnest = `-- name: Unnest :many
+SELECT n FROM unnest($1::int[]) AS n
+`
+
+func (q *Queries) Unnest(ctx context.Context, dollar_1 []int32) ([]int32, error) {
+	rows, err := q.db.QueryContext(ctx, unnest, pq.Array(dollar_1))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int32
+	for rows.Next() {
+		var n int32
+		if err := rows.Scan(&n); err != nil {
+			return nil, err
+		}
+		items = append(items, n)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const unnestOrdinality = `-- name: UnnestOrdinality :many
+SELECT tag, position FROM unnest($1::text[]) WITH ORDINALITY AS t(tag, position)
+`
+
+type UnnestOrdinalityRow struct {
+	Tag      string
+	Position int64
+}
+
+func (q *Queries) UnnestOrdinality(ctx context.Context, dollar_1 []string) ([]UnnestOrdinalityRow, error) {
+	rows, err := q.db.QueryContext(ctx, unnestOrdinality, pq.Array(dollar_1))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UnnestOrdinalityRow
+	for rows.Next() {
+		var i UnnestOrdinalityRow
+		if err := rows.Scan(&i.Tag, &i.Position); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
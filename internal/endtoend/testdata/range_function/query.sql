@@ -0,0 +1,27 @@
+CREATE TABLE users (id integer not null, tags text[] not null);
+
+CREATE FUNCTION list_users(min_id integer) RETURNS SETOF users AS $$
+    SELECT * FROM users WHERE id >= min_id
+$$ LANGUAGE SQL;
+
+-- name: Unnest :many
+SELECT * FROM unnest($1::int[]) AS n;
+
+-- name: UnnestOrdinality :many
+SELECT * FROM unnest($1::text[]) WITH ORDINALITY AS t(tag, position);
+
+-- name: RecordSet :many
+SELECT x.a, x.b FROM json_to_recordset($1::json) AS x(a int, b text);
+
+-- name: SetofTable :many
+SELECT * FROM list_users($1);
+
+-- name: LateralTags :many
+SELECT users.id, tag FROM users, LATERAL unnest(users.tags) AS tag;
+
+-- name: LateralSubquery :many
+SELECT u.id, latest.tag
+FROM users u
+CROSS JOIN LATERAL (
+    SELECT t.tag FROM unnest(u.tags) AS t(tag) WHERE u.id > 0 LIMIT 1
+) AS latest;
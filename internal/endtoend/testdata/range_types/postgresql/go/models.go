@@ -0,0 +1,546 @@
+// Code generated by sqlc. DO NOT EDIT.
+
+package querytest
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Daterange struct {
+	Lower          sql.NullTime
+	Upper          sql.NullTime
+	LowerInclusive bool
+	UpperInclusive bool
+	// LowerInfinity and UpperInfinity are -1 when the bound is -infinity and
+	// 1 when it's infinity, in which case Lower or Upper is ignored
+	LowerInfinity int
+	UpperInfinity int
+	Empty         bool
+}
+
+func (r *Daterange) Scan(src interface{}) error {
+	var literal string
+	switch s := src.(type) {
+	case []byte:
+		literal = string(s)
+	case string:
+		literal = s
+	case nil:
+		*r = Daterange{}
+		return nil
+	default:
+		return fmt.Errorf("unsupported scan type for Daterange: %T", src)
+	}
+	bounds, lowerInclusive, upperInclusive, err := parseRangeLiteral(literal)
+	if err != nil {
+		return err
+	}
+	*r = Daterange{LowerInclusive: lowerInclusive, UpperInclusive: upperInclusive, Empty: bounds == nil}
+	if r.Empty {
+		return nil
+	}
+	if r.LowerInfinity = rangeInfinity(bounds[0]); r.LowerInfinity != 0 {
+		bounds[0] = nil
+	}
+	if r.UpperInfinity = rangeInfinity(bounds[1]); r.UpperInfinity != 0 {
+		bounds[1] = nil
+	}
+	if err := scanTextField(&r.Lower, bounds[0]); err != nil {
+		return fmt.Errorf("Lower: %w", err)
+	}
+	if err := scanTextField(&r.Upper, bounds[1]); err != nil {
+		return fmt.Errorf("Upper: %w", err)
+	}
+	return nil
+}
+
+func (r Daterange) Value() (driver.Value, error) {
+	return rangeLiteral(rangeBound(r.Lower, r.LowerInfinity), rangeBound(r.Upper, r.UpperInfinity), r.LowerInclusive, r.UpperInclusive, r.Empty)
+}
+
+// NullDaterange is a Daterange that may be NULL.
+type NullDaterange struct {
+	Range Daterange
+	Valid bool
+}
+
+func (n *NullDaterange) Scan(src interface{}) error {
+	if src == nil {
+		*n = NullDaterange{}
+		return nil
+	}
+	n.Valid = true
+	return n.Range.Scan(src)
+}
+
+func (n NullDaterange) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Range.Value()
+}
+
+type Floatrange struct {
+	Lower          sql.NullFloat64
+	Upper          sql.NullFloat64
+	LowerInclusive bool
+	UpperInclusive bool
+	Empty          bool
+}
+
+func (r *Floatrange) Scan(src interface{}) error {
+	var literal string
+	switch s := src.(type) {
+	case []byte:
+		literal = string(s)
+	case string:
+		literal = s
+	case nil:
+		*r = Floatrange{}
+		return nil
+	default:
+		return fmt.Errorf("unsupported scan type for Floatrange: %T", src)
+	}
+	bounds, lowerInclusive, upperInclusive, err := parseRangeLiteral(literal)
+	if err != nil {
+		return err
+	}
+	*r = Floatrange{LowerInclusive: lowerInclusive, UpperInclusive: upperInclusive, Empty: bounds == nil}
+	if r.Empty {
+		return nil
+	}
+	if err := scanTextField(&r.Lower, bounds[0]); err != nil {
+		return fmt.Errorf("Lower: %w", err)
+	}
+	if err := scanTextField(&r.Upper, bounds[1]); err != nil {
+		return fmt.Errorf("Upper: %w", err)
+	}
+	return nil
+}
+
+func (r Floatrange) Value() (driver.Value, error) {
+	return rangeLiteral(r.Lower, r.Upper, r.LowerInclusive, r.UpperInclusive, r.Empty)
+}
+
+type Int4range struct {
+	Lower          sql.NullInt32
+	Upper          sql.NullInt32
+	LowerInclusive bool
+	UpperInclusive bool
+	Empty          bool
+}
+
+func (r *Int4range) Scan(src interface{}) error {
+	var literal string
+	switch s := src.(type) {
+	case []byte:
+		literal = string(s)
+	case string:
+		literal = s
+	case nil:
+		*r = Int4range{}
+		return nil
+	default:
+		return fmt.Errorf("unsupported scan type for Int4range: %T", src)
+	}
+	bounds, lowerInclusive, upperInclusive, err := parseRangeLiteral(literal)
+	if err != nil {
+		return err
+	}
+	*r = Int4range{LowerInclusive: lowerInclusive, UpperInclusive: upperInclusive, Empty: bounds == nil}
+	if r.Empty {
+		return nil
+	}
+	if err := scanTextField(&r.Lower, bounds[0]); err != nil {
+		return fmt.Errorf("Lower: %w", err)
+	}
+	if err := scanTextField(&r.Upper, bounds[1]); err != nil {
+		return fmt.Errorf("Upper: %w", err)
+	}
+	return nil
+}
+
+func (r Int4range) Value() (driver.Value, error) {
+	return rangeLiteral(r.Lower, r.Upper, r.LowerInclusive, r.UpperInclusive, r.Empty)
+}
+
+type Tstzrange struct {
+	Lower          sql.NullTime
+	Upper          sql.NullTime
+	LowerInclusive bool
+	UpperInclusive bool
+	// LowerInfinity and UpperInfinity are -1 when the bound is -infinity and
+	// 1 when it's infinity, in which case Lower or Upper is ignored
+	LowerInfinity int
+	UpperInfinity int
+	Empty         bool
+}
+
+func (r *Tstzrange) Scan(src interface{}) error {
+	var literal string
+	switch s := src.(type) {
+	case []byte:
+		literal = string(s)
+	case string:
+		literal = s
+	case nil:
+		*r = Tstzrange{}
+		return nil
+	default:
+		return fmt.Errorf("unsupported scan type for Tstzrange: %T", src)
+	}
+	bounds, lowerInclusive, upperInclusive, err := parseRangeLiteral(literal)
+	if err != nil {
+		return err
+	}
+	*r = Tstzrange{LowerInclusive: lowerInclusive, UpperInclusive: upperInclusive, Empty: bounds == nil}
+	if r.Empty {
+		return nil
+	}
+	if r.LowerInfinity = rangeInfinity(bounds[0]); r.LowerInfinity != 0 {
+		bounds[0] = nil
+	}
+	if r.UpperInfinity = rangeInfinity(bounds[1]); r.UpperInfinity != 0 {
+		bounds[1] = nil
+	}
+	if err := scanTextField(&r.Lower, bounds[0]); err != nil {
+		return fmt.Errorf("Lower: %w", err)
+	}
+	if err := scanTextField(&r.Upper, bounds[1]); err != nil {
+		return fmt.Errorf("Upper: %w", err)
+	}
+	return nil
+}
+
+func (r Tstzrange) Value() (driver.Value, error) {
+	return rangeLiteral(rangeBound(r.Lower, r.LowerInfinity), rangeBound(r.Upper, r.UpperInfinity), r.LowerInclusive, r.UpperInclusive, r.Empty)
+}
+
+type Datemultirange []Daterange
+
+func (m *Datemultirange) Scan(src interface{}) error {
+	var literal string
+	switch s := src.(type) {
+	case []byte:
+		literal = string(s)
+	case string:
+		literal = s
+	case nil:
+		*m = nil
+		return nil
+	default:
+		return fmt.Errorf("unsupported scan type for Datemultirange: %T", src)
+	}
+	ranges, err := parseMultirangeLiteral(literal)
+	if err != nil {
+		return err
+	}
+	*m = make(Datemultirange, len(ranges))
+	for i, r := range ranges {
+		if err := (*m)[i].Scan(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Datemultirange) Value() (driver.Value, error) {
+	if m == nil {
+		return nil, nil
+	}
+	ranges := make([]driver.Valuer, len(m))
+	for i := range m {
+		ranges[i] = m[i]
+	}
+	return multirangeLiteral(ranges)
+}
+
+// parseRangeLiteral splits the text format of a range, such as "[1,10)", into
+// its bounds. A nil bound is unbounded; nil bounds mean the range is empty.
+func parseRangeLiteral(s string) ([]*string, bool, bool, error) {
+	if s == "empty" {
+		return nil, false, false, nil
+	}
+	if len(s) < 2 || (s[0] != '[' && s[0] != '(') || (s[len(s)-1] != ']' && s[len(s)-1] != ')') {
+		return nil, false, false, fmt.Errorf("invalid range literal: %q", s)
+	}
+	bounds := splitTextFields(s[1 : len(s)-1])
+	if len(bounds) != 2 {
+		return nil, false, false, fmt.Errorf("invalid range literal: %q", s)
+	}
+	return bounds, s[0] == '[', s[len(s)-1] == ']', nil
+}
+
+// rangeInfinity returns -1 if a bound is -infinity, 1 if it's infinity, and 0
+// otherwise.
+func rangeInfinity(bound *string) int {
+	switch {
+	case bound == nil:
+		return 0
+	case strings.EqualFold(*bound, "-infinity"):
+		return -1
+	case strings.EqualFold(*bound, "infinity"):
+		return 1
+	}
+	return 0
+}
+
+// rangeBound returns the bound of a range, or infinity or -infinity in its
+// place.
+func rangeBound(bound interface{}, infinity int) interface{} {
+	switch {
+	case infinity < 0:
+		return "-infinity"
+	case infinity > 0:
+		return "infinity"
+	}
+	return bound
+}
+
+// rangeLiteral returns the text format of a range. A NULL bound is unbounded.
+func rangeLiteral(lower, upper interface{}, lowerInclusive, upperInclusive, empty bool) (driver.Value, error) {
+	if empty {
+		return "empty", nil
+	}
+	var b strings.Builder
+	if lowerInclusive {
+		b.WriteByte('[')
+	} else {
+		b.WriteByte('(')
+	}
+	if err := writeTextField(&b, lower); err != nil {
+		return nil, err
+	}
+	b.WriteByte(',')
+	if err := writeTextField(&b, upper); err != nil {
+		return nil, err
+	}
+	if upperInclusive {
+		b.WriteByte(']')
+	} else {
+		b.WriteByte(')')
+	}
+	return b.String(), nil
+}
+
+// parseMultirangeLiteral splits the text format of a multirange, such as
+// "{[1,3),[5,7)}", into the text format of its ranges.
+func parseMultirangeLiteral(s string) ([]string, error) {
+	if len(s) < 2 || s[0] != '{' || s[len(s)-1] != '}' {
+		return nil, fmt.Errorf("invalid multirange literal: %q", s)
+	}
+	s = s[1 : len(s)-1]
+	var ranges []string
+	start, depth, quoted := 0, 0, false
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '\\':
+			i++
+		case c == '"':
+			quoted = !quoted
+		case quoted:
+		case c == '[' || c == '(':
+			depth++
+		case c == ']' || c == ')':
+			depth--
+		case c == ',':
+			if depth == 0 {
+				ranges = append(ranges, strings.TrimSpace(s[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	if strings.TrimSpace(s) != "" {
+		ranges = append(ranges, strings.TrimSpace(s[start:]))
+	}
+	return ranges, nil
+}
+
+// multirangeLiteral returns the text format of a multirange.
+func multirangeLiteral(ranges []driver.Valuer) (driver.Value, error) {
+	var b strings.Builder
+	b.WriteByte('{')
+	for i, r := range ranges {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		v, err := r.Value()
+		if err != nil {
+			return nil, err
+		}
+		b.WriteString(v.(string))
+	}
+	b.WriteByte('}')
+	return b.String(), nil
+}
+
+// splitTextFields splits the fields of a composite value or the bounds of a
+// range, which are separated by commas and may be quoted. A nil field is NULL.
+func splitTextFields(s string) []*string {
+	var fields []*string
+	var b strings.Builder
+	quoted, present := false, false
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '\\' && i+1 < len(s):
+			i++
+			b.WriteByte(s[i])
+			present = true
+		case c == '"' && quoted && i+1 < len(s) && s[i+1] == '"':
+			i++
+			b.WriteByte('"')
+		case c == '"':
+			quoted = !quoted
+			present = true
+		case c == ',' && !quoted:
+			fields = append(fields, textField(&b, present))
+			present = false
+		default:
+			b.WriteByte(c)
+			present = true
+		}
+	}
+	return append(fields, textField(&b, present))
+}
+
+func textField(b *strings.Builder, present bool) *string {
+	if !present {
+		return nil
+	}
+	s := b.String()
+	b.Reset()
+	return &s
+}
+
+// scanTextField converts the text of a field into dst. NULL fields leave dst
+// unchanged.
+func scanTextField(dst interface{}, src *string) error {
+	if src == nil {
+		return nil
+	}
+	switch d := dst.(type) {
+	case *json.RawMessage:
+		*d = json.RawMessage(*src)
+		return nil
+	case *time.Time:
+		t, err := parseTextTime(*src)
+		*d = t
+		return err
+	case *sql.NullTime:
+		t, err := parseTextTime(*src)
+		*d = sql.NullTime{Time: t, Valid: err == nil}
+		return err
+	case sql.Scanner:
+		return d.Scan(*src)
+	}
+	v := reflect.ValueOf(dst).Elem()
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(*src)
+	case reflect.Slice:
+		if v.Type().Elem().Kind() != reflect.Uint8 {
+			return fmt.Errorf("unsupported field type %T", dst)
+		}
+		b, err := hex.DecodeString(strings.TrimPrefix(*src, "\\x"))
+		if err != nil {
+			return err
+		}
+		v.SetBytes(b)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(*src)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(*src, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(*src, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	case reflect.Interface:
+		v.Set(reflect.ValueOf(*src))
+	default:
+		return fmt.Errorf("unsupported field type %T", dst)
+	}
+	return nil
+}
+
+func parseTextTime(s string) (time.Time, error) {
+	for _, layout := range []string{
+		"2006-01-02 15:04:05.999999999Z07",
+		"2006-01-02 15:04:05.999999999Z07:00",
+		"2006-01-02 15:04:05.999999999",
+		"2006-01-02",
+		"15:04:05.999999999",
+	} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time: %q", s)
+}
+
+// writeTextField writes a quoted field of a composite value or a bound of a
+// range. NULL is written as nothing.
+func writeTextField(b *strings.Builder, field interface{}) error {
+	if v, ok := field.(driver.Valuer); ok {
+		val, err := v.Value()
+		if err != nil {
+			return err
+		}
+		field = val
+	}
+	var s string
+	switch f := field.(type) {
+	case nil:
+		return nil
+	case string:
+		s = f
+	case json.RawMessage:
+		if f == nil {
+			return nil
+		}
+		s = string(f)
+	case []byte:
+		s = "\\x" + hex.EncodeToString(f)
+	case time.Time:
+		s = f.Format("2006-01-02 15:04:05.999999999Z07:00")
+	default:
+		v := reflect.ValueOf(field)
+		switch {
+		case v.Kind() == reflect.String:
+			s = v.String()
+		case v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8:
+			s = "\\x" + hex.EncodeToString(v.Bytes())
+		default:
+			s = fmt.Sprint(field)
+		}
+	}
+	b.WriteByte('"')
+	b.WriteString(strings.NewReplacer("\\", "\\\\", "\"", "\\\"").Replace(s))
+	b.WriteByte('"')
+	return nil
+}
+
+type Reservation struct {
+	ID          int32
+	Room        Int4range
+	During      Tstzrange
+	Stay        NullDaterange
+	Temperature Floatrange
+}
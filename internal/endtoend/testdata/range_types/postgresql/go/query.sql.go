@@ -0,0 +1,142 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"time"
+)
+
+const createReservation = `-- name: CreateReservation :one
+INSERT INTO reservations (room, during, stay, temperature)
+VALUES ($1, $2, $3, $4)
+RETURNING id, room, during, stay, temperature
+`
+
+type CreateReservationParams struct {
+	Room        Int4range
+	During      Tstzrange
+	Stay        NullDaterange
+	Temperature Floatrange
+}
+
+func (q *Queries) CreateReservation(ctx context.Context, arg CreateReservationParams) (Reservation, error) {
+	row := q.db.QueryRowContext(ctx, createReservation,
+		arg.Room,
+		arg.During,
+		arg.Stay,
+		arg.Temperature,
+	)
+	var i Reservation
+	err := row.Scan(
+		&i.ID,
+		&i.Room,
+		&i.During,
+		&i.Stay,
+		&i.Temperature,
+	)
+	return i, err
+}
+
+const getIntersection = `-- name: GetIntersection :one
+SELECT during * $1::tstzrange AS intersection
+FROM reservations
+WHERE id = $2
+`
+
+type GetIntersectionParams struct {
+	Period Tstzrange
+	ID     int32
+}
+
+func (q *Queries) GetIntersection(ctx context.Context, arg GetIntersectionParams) (Tstzrange, error) {
+	row := q.db.QueryRowContext(ctx, getIntersection, arg.Period, arg.ID)
+	var intersection Tstzrange
+	err := row.Scan(&intersection)
+	return intersection, err
+}
+
+const listOverlapping = `-- name: ListOverlapping :many
+SELECT id, during && $1::tstzrange AS overlaps
+FROM reservations
+WHERE room && $2
+`
+
+type ListOverlappingParams struct {
+	Period Tstzrange
+	Rooms  Int4range
+}
+
+type ListOverlappingRow struct {
+	ID       int32
+	Overlaps bool
+}
+
+func (q *Queries) ListOverlapping(ctx context.Context, arg ListOverlappingParams) ([]ListOverlappingRow, error) {
+	rows, err := q.db.QueryContext(ctx, listOverlapping, arg.Period, arg.Rooms)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListOverlappingRow
+	for rows.Next() {
+		var i ListOverlappingRow
+		if err := rows.Scan(&i.ID, &i.Overlaps); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listReservations = `-- name: ListReservations :many
+SELECT id, room, during, stay, temperature FROM reservations
+WHERE during @> $1::timestamptz
+`
+
+func (q *Queries) ListReservations(ctx context.Context, at time.Time) ([]Reservation, error) {
+	rows, err := q.db.QueryContext(ctx, listReservations, at)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Reservation
+	for rows.Next() {
+		var i Reservation
+		if err := rows.Scan(
+			&i.ID,
+			&i.Room,
+			&i.During,
+			&i.Stay,
+			&i.Temperature,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const mergeStays = `-- name: MergeStays :one
+SELECT $1::datemultirange AS merged
+`
+
+func (q *Queries) MergeStays(ctx context.Context, stays Datemultirange) (Datemultirange, error) {
+	row := q.db.QueryRowContext(ctx, mergeStays, stays)
+	var merged Datemultirange
+	err := row.Scan(&merged)
+	return merged, err
+}
@@ -0,0 +1,21 @@
+-- name: ListReservations :many
+SELECT * FROM reservations
+WHERE during @> sqlc.arg(at)::timestamptz;
+
+-- name: ListOverlapping :many
+SELECT id, during && sqlc.arg(period)::tstzrange AS overlaps
+FROM reservations
+WHERE room && sqlc.arg(rooms);
+
+-- name: CreateReservation :one
+INSERT INTO reservations (room, during, stay, temperature)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: GetIntersection :one
+SELECT during * sqlc.arg(period)::tstzrange AS intersection
+FROM reservations
+WHERE id = sqlc.arg(id);
+
+-- name: MergeStays :one
+SELECT sqlc.arg(stays)::datemultirange AS merged;
@@ -0,0 +1,12 @@
+CREATE TYPE floatrange AS RANGE (
+    subtype = float8,
+    subtype_diff = float8mi
+);
+
+CREATE TABLE reservations (
+    id          SERIAL PRIMARY KEY,
+    room        int4range NOT NULL,
+    during      tstzrange NOT NULL,
+    stay        daterange,
+    temperature floatrange NOT NULL
+);
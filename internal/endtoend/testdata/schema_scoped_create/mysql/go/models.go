@@ -5,6 +5,6 @@ package querytest
 import ()
 
 type FooBar struct {
-	ID   int64
+	ID   uint64
 	Name string
 }
@@ -11,7 +11,7 @@ const schemaScopedDelete = `-- name: SchemaScopedDelete :exec
 DELETE FROM foo.bar WHERE id = ?
 `
 
-func (q *Queries) SchemaScopedDelete(ctx context.Context, id int64) error {
+func (q *Queries) SchemaScopedDelete(ctx context.Context, id uint64) error {
 	_, err := q.db.ExecContext(ctx, schemaScopedDelete, id)
 	return err
 }
@@ -5,5 +5,5 @@ package querytest
 import ()
 
 type FooBar struct {
-	ID int64
+	ID uint64
 }
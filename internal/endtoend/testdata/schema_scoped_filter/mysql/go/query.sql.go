@@ -11,15 +11,15 @@ const schemaScopedFilter = `-- name: SchemaScopedFilter :many
 SELECT id FROM foo.bar WHERE id = ?
 `
 
-func (q *Queries) SchemaScopedFilter(ctx context.Context, id int64) ([]int64, error) {
+func (q *Queries) SchemaScopedFilter(ctx context.Context, id uint64) ([]uint64, error) {
 	rows, err := q.db.QueryContext(ctx, schemaScopedFilter, id)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []int64
+	var items []uint64
 	for rows.Next() {
-		var id int64
+		var id uint64
 		if err := rows.Scan(&id); err != nil {
 			return nil, err
 		}
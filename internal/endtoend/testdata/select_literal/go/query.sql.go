@@ -0,0 +1,41 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+)
+
+const literals = `-- name: Literals :many
+SELECT id, 1 AS small, 1.5 AS ratio FROM bar
+`
+
+type LiteralsRow struct {
+	ID    int32
+	Small int32
+	Ratio string
+}
+
+func (q *Queries) Literals(ctx context.Context) ([]LiteralsRow, error) {
+	rows, err := q.db.QueryContext(ctx, literals)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []LiteralsRow
+	for rows.Next() {
+		var i LiteralsRow
+		if err := rows.Scan(&i.ID, &i.Small, &i.Ratio); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
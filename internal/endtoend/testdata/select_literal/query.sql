@@ -0,0 +1,4 @@
+CREATE TABLE bar (id serial not null);
+
+-- name: Literals :many
+SELECT id, 1 AS small, 1.5 AS ratio FROM bar;
@@ -0,0 +1,161 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+)
+
+const except = `-- name: Except :many
+SELECT name FROM bar
+EXCEPT
+SELECT name FROM foo
+`
+
+func (q *Queries) Except(ctx context.Context) ([]sql.NullString, error) {
+	rows, err := q.db.QueryContext(ctx, except)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []sql.NullString
+	for rows.Next() {
+		var name sql.NullString
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		items = append(items, name)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const intersect = `-- name: Intersect :many
+SELECT name FROM foo
+INTERSECT
+SELECT name FROM bar
+`
+
+func (q *Queries) Intersect(ctx context.Context) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, intersect)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		items = append(items, name)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const unionAll = `-- name: UnionAll :many
+SELECT name, age FROM foo
+UNION ALL
+SELECT name, age FROM bar
+`
+
+type UnionAllRow struct {
+	Name sql.NullString
+	Age  int64
+}
+
+func (q *Queries) UnionAll(ctx context.Context) ([]UnionAllRow, error) {
+	rows, err := q.db.QueryContext(ctx, unionAll)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UnionAllRow
+	for rows.Next() {
+		var i UnionAllRow
+		if err := rows.Scan(&i.Name, &i.Age); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const unionNested = `-- name: UnionNested :many
+SELECT age FROM foo
+UNION
+SELECT age FROM bar
+UNION
+SELECT 1::smallint
+`
+
+func (q *Queries) UnionNested(ctx context.Context) ([]int64, error) {
+	rows, err := q.db.QueryContext(ctx, unionNested)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var age int64
+		if err := rows.Scan(&age); err != nil {
+			return nil, err
+		}
+		items = append(items, age)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const unionSame = `-- name: UnionSame :many
+SELECT name, age FROM foo
+UNION
+SELECT name, age FROM foo
+`
+
+func (q *Queries) UnionSame(ctx context.Context) ([]Foo, error) {
+	rows, err := q.db.QueryContext(ctx, unionSame)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Foo
+	for rows.Next() {
+		var i Foo
+		if err := rows.Scan(&i.Name, &i.Age); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
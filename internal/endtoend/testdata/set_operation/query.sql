@@ -0,0 +1,29 @@
+CREATE TABLE foo (name text not null, age integer not null);
+CREATE TABLE bar (name text, age bigint not null);
+
+-- name: UnionAll :many
+SELECT name, age FROM foo
+UNION ALL
+SELECT name, age FROM bar;
+
+-- name: Intersect :many
+SELECT name FROM foo
+INTERSECT
+SELECT name FROM bar;
+
+-- name: Except :many
+SELECT name FROM bar
+EXCEPT
+SELECT name FROM foo;
+
+-- name: UnionSame :many
+SELECT * FROM foo
+UNION
+SELECT * FROM foo;
+
+-- name: UnionNested :many
+SELECT age FROM foo
+UNION
+SELECT age FROM bar
+UNION
+SELECT 1::smallint;
@@ -0,0 +1,11 @@
+CREATE TABLE foo (name text not null, age integer not null);
+
+-- name: ColumnCount :many
+SELECT name, age FROM foo
+UNION
+SELECT name FROM foo;
+
+-- name: ColumnType :many
+SELECT name, age FROM foo
+UNION
+SELECT name, name FROM foo;
@@ -5,21 +5,22 @@ package querytest
 
 import (
 	"context"
+	"database/sql"
 )
 
 const subqueryCalcColumn = `-- name: SubqueryCalcColumn :many
 SELECT sum FROM (SELECT a + b AS sum FROM foo) AS f
 `
 
-func (q *Queries) SubqueryCalcColumn(ctx context.Context) ([]int32, error) {
+func (q *Queries) SubqueryCalcColumn(ctx context.Context) ([]sql.NullInt64, error) {
 	rows, err := q.db.QueryContext(ctx, subqueryCalcColumn)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []int32
+	var items []sql.NullInt64
 	for rows.Next() {
-		var sum int32
+		var sum sql.NullInt64
 		if err := rows.Scan(&sum); err != nil {
 			return nil, err
 		}
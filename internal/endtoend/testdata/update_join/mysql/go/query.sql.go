@@ -0,0 +1,124 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+)
+
+const updateAuthorBioByBookTitle = `-- name: UpdateAuthorBioByBookTitle :exec
+UPDATE books b
+JOIN authors a ON a.id = b.author_id
+SET bio = ?
+WHERE b.title = ?
+`
+
+type UpdateAuthorBioByBookTitleParams struct {
+	Bio   sql.NullString
+	Title string
+}
+
+func (q *Queries) UpdateAuthorBioByBookTitle(ctx context.Context, arg UpdateAuthorBioByBookTitleParams) error {
+	_, err := q.db.ExecContext(ctx, updateAuthorBioByBookTitle, arg.Bio, arg.Title)
+	return err
+}
+
+const updateAuthorBioByTitle = `-- name: UpdateAuthorBioByTitle :execrows
+UPDATE authors
+INNER JOIN books ON books.author_id = authors.id AND books.available = ?
+SET bio = ?
+WHERE books.title = ?
+`
+
+type UpdateAuthorBioByTitleParams struct {
+	Available bool
+	Bio       sql.NullString
+	Title     string
+}
+
+func (q *Queries) UpdateAuthorBioByTitle(ctx context.Context, arg UpdateAuthorBioByTitleParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateAuthorBioByTitle, arg.Available, arg.Bio, arg.Title)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const updateAuthorBioOfBooks = `-- name: UpdateAuthorBioOfBooks :exec
+UPDATE books b
+LEFT JOIN authors a ON a.id = b.author_id
+SET a.bio = ?
+WHERE b.available = ?
+`
+
+type UpdateAuthorBioOfBooksParams struct {
+	Bio       sql.NullString
+	Available bool
+}
+
+func (q *Queries) UpdateAuthorBioOfBooks(ctx context.Context, arg UpdateAuthorBioOfBooksParams) error {
+	_, err := q.db.ExecContext(ctx, updateAuthorBioOfBooks, arg.Bio, arg.Available)
+	return err
+}
+
+const updateBooksAvailable = `-- name: UpdateBooksAvailable :exec
+UPDATE books, authors
+SET books.available = ?
+WHERE books.author_id = authors.id AND authors.bio IS NULL
+`
+
+func (q *Queries) UpdateBooksAvailable(ctx context.Context, available bool) error {
+	_, err := q.db.ExecContext(ctx, updateBooksAvailable, available)
+	return err
+}
+
+const updateBooksByAuthorName = `-- name: UpdateBooksByAuthorName :exec
+UPDATE books b
+JOIN authors a ON a.id = b.author_id
+SET b.title = ?
+WHERE a.name = ?
+`
+
+type UpdateBooksByAuthorNameParams struct {
+	Title string
+	Name  string
+}
+
+func (q *Queries) UpdateBooksByAuthorName(ctx context.Context, arg UpdateBooksByAuthorNameParams) error {
+	_, err := q.db.ExecContext(ctx, updateBooksByAuthorName, arg.Title, arg.Name)
+	return err
+}
+
+const updateBooksWithoutAuthor = `-- name: UpdateBooksWithoutAuthor :execrows
+UPDATE books b
+LEFT JOIN authors a ON a.id = b.author_id
+SET b.available = ?
+WHERE a.id IS NULL
+`
+
+func (q *Queries) UpdateBooksWithoutAuthor(ctx context.Context, available bool) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateBooksWithoutAuthor, available)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const updateBooksWithoutBio = `-- name: UpdateBooksWithoutBio :exec
+UPDATE books
+LEFT JOIN authors ON authors.id = books.author_id
+SET title = ?
+WHERE authors.bio IS NULL AND books.author_id = ?
+`
+
+type UpdateBooksWithoutBioParams struct {
+	Title    string
+	AuthorID int64
+}
+
+func (q *Queries) UpdateBooksWithoutBio(ctx context.Context, arg UpdateBooksWithoutBioParams) error {
+	_, err := q.db.ExecContext(ctx, updateBooksWithoutBio, arg.Title, arg.AuthorID)
+	return err
+}
@@ -0,0 +1,40 @@
+-- name: UpdateBooksByAuthorName :exec
+UPDATE books b
+JOIN authors a ON a.id = b.author_id
+SET b.title = ?
+WHERE a.name = ?;
+
+-- name: UpdateAuthorBioByTitle :execrows
+UPDATE authors
+INNER JOIN books ON books.author_id = authors.id AND books.available = ?
+SET bio = ?
+WHERE books.title = ?;
+
+-- name: UpdateBooksAvailable :exec
+UPDATE books, authors
+SET books.available = ?
+WHERE books.author_id = authors.id AND authors.bio IS NULL;
+
+-- name: UpdateAuthorBioByBookTitle :exec
+UPDATE books b
+JOIN authors a ON a.id = b.author_id
+SET bio = ?
+WHERE b.title = ?;
+
+-- name: UpdateAuthorBioOfBooks :exec
+UPDATE books b
+LEFT JOIN authors a ON a.id = b.author_id
+SET a.bio = ?
+WHERE b.available = ?;
+
+-- name: UpdateBooksWithoutAuthor :execrows
+UPDATE books b
+LEFT JOIN authors a ON a.id = b.author_id
+SET b.available = ?
+WHERE a.id IS NULL;
+
+-- name: UpdateBooksWithoutBio :exec
+UPDATE books
+LEFT JOIN authors ON authors.id = books.author_id
+SET title = ?
+WHERE authors.bio IS NULL AND books.author_id = ?;
@@ -0,0 +1,12 @@
+CREATE TABLE authors (
+    id   BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    name TEXT NOT NULL,
+    bio  TEXT
+);
+
+CREATE TABLE books (
+    id        BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    author_id BIGINT NOT NULL,
+    title     TEXT NOT NULL,
+    available BOOLEAN NOT NULL
+);
@@ -0,0 +1,5 @@
+-- name: UpdateTitleAndBio :exec
+UPDATE books b
+JOIN authors a ON a.id = b.author_id
+SET b.title = ?, a.bio = ?
+WHERE b.id = ?;
@@ -0,0 +1,17 @@
+-- name: UpdateTitleAndBioUnqualified :exec
+UPDATE books
+JOIN authors ON authors.id = books.author_id
+SET title = ?, bio = ?
+WHERE books.id = ?;
+
+-- name: UpdateUnknownColumn :exec
+UPDATE books
+JOIN authors ON authors.id = books.author_id
+SET missing = ?
+WHERE books.id = ?;
+
+-- name: UpdateAmbiguousColumn :exec
+UPDATE books
+JOIN authors ON authors.id = books.author_id
+SET id = ?
+WHERE books.title = ?;
@@ -0,0 +1,41 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+)
+
+const upsertCounter = `-- name: UpsertCounter :exec
+INSERT INTO counters (name, hits) VALUES (?, ?)
+ON DUPLICATE KEY UPDATE hits = hits + VALUES(hits), note = ?
+`
+
+type UpsertCounterParams struct {
+	Name string
+	Hits int32
+	Note sql.NullString
+}
+
+func (q *Queries) UpsertCounter(ctx context.Context, arg UpsertCounterParams) error {
+	_, err := q.db.ExecContext(ctx, upsertCounter, arg.Name, arg.Hits, arg.Note)
+	return err
+}
+
+const upsertVisit = `-- name: UpsertVisit :exec
+INSERT INTO visits (site, path, views) VALUES (?, ?, 1)
+ON DUPLICATE KEY UPDATE views = views + ?
+`
+
+type UpsertVisitParams struct {
+	Site  string
+	Path  string
+	Views int64
+}
+
+func (q *Queries) UpsertVisit(ctx context.Context, arg UpsertVisitParams) error {
+	_, err := q.db.ExecContext(ctx, upsertVisit, arg.Site, arg.Path, arg.Views)
+	return err
+}
@@ -0,0 +1,20 @@
+CREATE TABLE counters (
+    name varchar(255) PRIMARY KEY,
+    hits int NOT NULL,
+    note text
+);
+
+CREATE TABLE visits (
+    site varchar(255) NOT NULL,
+    path varchar(255) NOT NULL,
+    views bigint NOT NULL,
+    UNIQUE KEY visits_page (site, path)
+);
+
+-- name: UpsertCounter :exec
+INSERT INTO counters (name, hits) VALUES (?, ?)
+ON DUPLICATE KEY UPDATE hits = hits + VALUES(hits), note = ?;
+
+-- name: UpsertVisit :exec
+INSERT INTO visits (site, path, views) VALUES (?, ?, 1)
+ON DUPLICATE KEY UPDATE views = views + ?;
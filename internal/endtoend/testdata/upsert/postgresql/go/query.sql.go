@@ -0,0 +1,117 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const copyStaging = `-- name: CopyStaging :exec
+INSERT INTO counters (name, hits, updated_at)
+SELECT name, hits, now() FROM staging WHERE staging.hits > $1
+ON CONFLICT (name) DO UPDATE SET hits = EXCLUDED.hits + $2
+`
+
+type CopyStagingParams struct {
+	Hits   int32
+	Hits_2 int32
+}
+
+func (q *Queries) CopyStaging(ctx context.Context, arg CopyStagingParams) error {
+	_, err := q.db.ExecContext(ctx, copyStaging, arg.Hits, arg.Hits_2)
+	return err
+}
+
+const insertStaging = `-- name: InsertStaging :exec
+INSERT INTO staging (name, hits) VALUES ($1, $2)
+ON CONFLICT (name) DO NOTHING
+`
+
+type InsertStagingParams struct {
+	Name string
+	Hits int32
+}
+
+func (q *Queries) InsertStaging(ctx context.Context, arg InsertStagingParams) error {
+	_, err := q.db.ExecContext(ctx, insertStaging, arg.Name, arg.Hits)
+	return err
+}
+
+const upsertCounter = `-- name: UpsertCounter :exec
+INSERT INTO counters (name, hits, updated_at) VALUES ($1, $2, now())
+ON CONFLICT (name) DO UPDATE
+SET hits = counters.hits + EXCLUDED.hits, note = $3
+WHERE counters.updated_at < $4
+`
+
+type UpsertCounterParams struct {
+	Name      string
+	Hits      int32
+	Note      sql.NullString
+	UpdatedAt time.Time
+}
+
+func (q *Queries) UpsertCounter(ctx context.Context, arg UpsertCounterParams) error {
+	_, err := q.db.ExecContext(ctx, upsertCounter,
+		arg.Name,
+		arg.Hits,
+		arg.Note,
+		arg.UpdatedAt,
+	)
+	return err
+}
+
+const upsertVisit = `-- name: UpsertVisit :one
+INSERT INTO visits (site, path, day, views) VALUES ($1, $2, $3, 1)
+ON CONFLICT ON CONSTRAINT visits_page DO UPDATE
+SET views = visits.views + $4
+RETURNING views
+`
+
+type UpsertVisitParams struct {
+	Site  string
+	Path  string
+	Day   time.Time
+	Views int64
+}
+
+func (q *Queries) UpsertVisit(ctx context.Context, arg UpsertVisitParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, upsertVisit,
+		arg.Site,
+		arg.Path,
+		arg.Day,
+		arg.Views,
+	)
+	var views int64
+	err := row.Scan(&views)
+	return views, err
+}
+
+const upsertVisitColumns = `-- name: UpsertVisitColumns :exec
+INSERT INTO visits AS v (site, path, day, views) VALUES ($1, $2, $3, $4)
+ON CONFLICT (day, site, path) DO UPDATE
+SET views = v.views + EXCLUDED.views
+WHERE EXCLUDED.views > $5
+`
+
+type UpsertVisitColumnsParams struct {
+	Site    string
+	Path    string
+	Day     time.Time
+	Views   int64
+	Views_2 int64
+}
+
+func (q *Queries) UpsertVisitColumns(ctx context.Context, arg UpsertVisitColumnsParams) error {
+	_, err := q.db.ExecContext(ctx, upsertVisitColumns,
+		arg.Site,
+		arg.Path,
+		arg.Day,
+		arg.Views,
+		arg.Views_2,
+	)
+	return err
+}
@@ -0,0 +1,45 @@
+CREATE TABLE counters (
+    name text PRIMARY KEY,
+    hits integer NOT NULL,
+    updated_at timestamp NOT NULL,
+    note text
+);
+
+CREATE TABLE visits (
+    site text NOT NULL,
+    path text NOT NULL,
+    day date NOT NULL,
+    views bigint NOT NULL,
+    CONSTRAINT visits_page UNIQUE (site, path, day)
+);
+
+CREATE TABLE staging (name text NOT NULL, hits integer NOT NULL);
+
+CREATE UNIQUE INDEX staging_name ON staging (name);
+
+-- name: UpsertCounter :exec
+INSERT INTO counters (name, hits, updated_at) VALUES ($1, $2, now())
+ON CONFLICT (name) DO UPDATE
+SET hits = counters.hits + EXCLUDED.hits, note = $3
+WHERE counters.updated_at < $4;
+
+-- name: UpsertVisit :one
+INSERT INTO visits (site, path, day, views) VALUES ($1, $2, $3, 1)
+ON CONFLICT ON CONSTRAINT visits_page DO UPDATE
+SET views = visits.views + $4
+RETURNING views;
+
+-- name: UpsertVisitColumns :exec
+INSERT INTO visits AS v (site, path, day, views) VALUES ($1, $2, $3, $4)
+ON CONFLICT (day, site, path) DO UPDATE
+SET views = v.views + EXCLUDED.views
+WHERE EXCLUDED.views > $5;
+
+-- name: CopyStaging :exec
+INSERT INTO counters (name, hits, updated_at)
+SELECT name, hits, now() FROM staging WHERE staging.hits > $1
+ON CONFLICT (name) DO UPDATE SET hits = EXCLUDED.hits + $2;
+
+-- name: InsertStaging :exec
+INSERT INTO staging (name, hits) VALUES ($1, $2)
+ON CONFLICT (name) DO NOTHING;
@@ -0,0 +1,29 @@
+CREATE TABLE counters (
+    name text PRIMARY KEY,
+    hits integer NOT NULL,
+    note text
+);
+
+-- name: NoMatchingConstraint :exec
+INSERT INTO counters (name, hits) VALUES ($1, $2)
+ON CONFLICT (hits) DO NOTHING;
+
+-- name: UnknownConstraint :exec
+INSERT INTO counters (name, hits) VALUES ($1, $2)
+ON CONFLICT ON CONSTRAINT counters_hits_key DO NOTHING;
+
+-- name: UnknownTargetColumn :exec
+INSERT INTO counters (name, hits) VALUES ($1, $2)
+ON CONFLICT (nme) DO NOTHING;
+
+-- name: UnknownExcludedColumn :exec
+INSERT INTO counters (name, hits) VALUES ($1, $2)
+ON CONFLICT (name) DO UPDATE SET hits = EXCLUDED.hitz;
+
+-- name: UnknownSetColumn :exec
+INSERT INTO counters (name, hits) VALUES ($1, $2)
+ON CONFLICT (name) DO UPDATE SET hitz = 1;
+
+-- name: WrongSetType :exec
+INSERT INTO counters (name, hits) VALUES ($1, $2)
+ON CONFLICT (name) DO UPDATE SET hits = EXCLUDED.note;
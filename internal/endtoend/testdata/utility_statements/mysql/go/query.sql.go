@@ -0,0 +1,44 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+)
+
+const createScratch = `-- name: CreateScratch :exec
+CREATE TEMPORARY TABLE scratch (id bigint NOT NULL)
+`
+
+func (q *Queries) CreateScratch(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, createScratch)
+	return err
+}
+
+const lockJobs = `-- name: LockJobs :exec
+LOCK TABLES jobs WRITE
+`
+
+func (q *Queries) LockJobs(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, lockJobs)
+	return err
+}
+
+const setTimeZone = `-- name: SetTimeZone :exec
+SET time_zone = ?
+`
+
+func (q *Queries) SetTimeZone(ctx context.Context, timeZone interface{}) error {
+	_, err := q.db.ExecContext(ctx, setTimeZone, timeZone)
+	return err
+}
+
+const setVariables = `-- name: SetVariables :exec
+SET @state = 'done', @limit = ?
+`
+
+func (q *Queries) SetVariables(ctx context.Context, limit interface{}) error {
+	_, err := q.db.ExecContext(ctx, setVariables, limit)
+	return err
+}
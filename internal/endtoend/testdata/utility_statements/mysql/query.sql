@@ -0,0 +1,13 @@
+CREATE TABLE jobs (id bigint PRIMARY KEY, state text NOT NULL);
+
+-- name: LockJobs :exec
+LOCK TABLES jobs WRITE;
+
+-- name: CreateScratch :exec
+CREATE TEMPORARY TABLE scratch (id bigint NOT NULL);
+
+-- name: SetTimeZone :exec
+SET time_zone = ?;
+
+-- name: SetVariables :exec
+SET @state = 'done', @limit = ?;
@@ -0,0 +1,62 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+)
+
+const createScratch = `-- name: CreateScratch :exec
+CREATE TEMP TABLE scratch (id bigint NOT NULL) ON COMMIT DROP
+`
+
+func (q *Queries) CreateScratch(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, createScratch)
+	return err
+}
+
+const lockJobs = `-- name: LockJobs :exec
+LOCK TABLE jobs IN ACCESS EXCLUSIVE MODE NOWAIT
+`
+
+func (q *Queries) LockJobs(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, lockJobs)
+	return err
+}
+
+const notifyJobs = `-- name: NotifyJobs :exec
+NOTIFY jobs, 'changed'
+`
+
+func (q *Queries) NotifyJobs(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, notifyJobs)
+	return err
+}
+
+const refreshStats = `-- name: RefreshStats :exec
+REFRESH MATERIALIZED VIEW CONCURRENTLY job_stats
+`
+
+func (q *Queries) RefreshStats(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, refreshStats)
+	return err
+}
+
+const setTimeout = `-- name: SetTimeout :exec
+SET LOCAL statement_timeout = '5s'
+`
+
+func (q *Queries) SetTimeout(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, setTimeout)
+	return err
+}
+
+const vacuumJobs = `-- name: VacuumJobs :exec
+VACUUM ANALYZE jobs
+`
+
+func (q *Queries) VacuumJobs(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, vacuumJobs)
+	return err
+}
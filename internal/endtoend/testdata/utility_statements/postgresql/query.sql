@@ -0,0 +1,19 @@
+CREATE TABLE jobs (id bigserial PRIMARY KEY, state text NOT NULL);
+
+-- name: RefreshStats :exec
+REFRESH MATERIALIZED VIEW CONCURRENTLY job_stats;
+
+-- name: LockJobs :exec
+LOCK TABLE jobs IN ACCESS EXCLUSIVE MODE NOWAIT;
+
+-- name: CreateScratch :exec
+CREATE TEMP TABLE scratch (id bigint NOT NULL) ON COMMIT DROP;
+
+-- name: SetTimeout :exec
+SET LOCAL statement_timeout = '5s';
+
+-- name: NotifyJobs :exec
+NOTIFY jobs, 'changed';
+
+-- name: VacuumJobs :exec
+VACUUM ANALYZE jobs;
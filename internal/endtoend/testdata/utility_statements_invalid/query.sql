@@ -0,0 +1,16 @@
+CREATE TABLE jobs (id bigserial PRIMARY KEY, state text NOT NULL);
+
+-- The schema statements above and below aren't named, so they're skipped
+ALTER TABLE jobs ADD COLUMN owner text;
+
+-- name: AddColumn :exec
+ALTER TABLE jobs ADD COLUMN priority integer;
+
+-- name: LockMissing :exec
+LOCK TABLE missing;
+
+-- name: TempWithParam :exec
+CREATE TEMP TABLE scratch (id bigint DEFAULT $1);
+
+-- name: NotifyMany :many
+NOTIFY jobs;
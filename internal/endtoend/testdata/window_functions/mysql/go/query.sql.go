@@ -0,0 +1,91 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+)
+
+const frame = `-- name: Frame :many
+SELECT player, SUM(points) OVER (ORDER BY played ROWS BETWEEN ? PRECEDING AND CURRENT ROW) AS total
+FROM scores WHERE player = ?
+`
+
+type FrameParams struct {
+	StartOffset int64
+	Player      string
+}
+
+type FrameRow struct {
+	Player string
+	Total  sql.NullString
+}
+
+func (q *Queries) Frame(ctx context.Context, arg FrameParams) ([]FrameRow, error) {
+	rows, err := q.db.QueryContext(ctx, frame, arg.StartOffset, arg.Player)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FrameRow
+	for rows.Next() {
+		var i FrameRow
+		if err := rows.Scan(&i.Player, &i.Total); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const ranked = `-- name: Ranked :many
+SELECT player, ROW_NUMBER() OVER (PARTITION BY player ORDER BY points DESC) AS rn,
+  RANK() OVER w AS rnk, LAG(points) OVER w AS prev, FIRST_VALUE(played) OVER w AS first
+FROM scores
+WINDOW w AS (PARTITION BY player ORDER BY played)
+`
+
+type RankedRow struct {
+	Player string
+	Rn     int64
+	Rnk    int64
+	Prev   sql.NullInt32
+	First  sql.NullTime
+}
+
+func (q *Queries) Ranked(ctx context.Context) ([]RankedRow, error) {
+	rows, err := q.db.QueryContext(ctx, ranked)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RankedRow
+	for rows.Next() {
+		var i RankedRow
+		if err := rows.Scan(
+			&i.Player,
+			&i.Rn,
+			&i.Rnk,
+			&i.Prev,
+			&i.First,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,16 @@
+CREATE TABLE scores (
+    id      INT PRIMARY KEY AUTO_INCREMENT,
+    player  VARCHAR(255) NOT NULL,
+    points  INT NOT NULL,
+    played  DATE NOT NULL
+);
+
+-- name: Ranked :many
+SELECT player, ROW_NUMBER() OVER (PARTITION BY player ORDER BY points DESC) AS rn,
+  RANK() OVER w AS rnk, LAG(points) OVER w AS prev, FIRST_VALUE(played) OVER w AS first
+FROM scores
+WINDOW w AS (PARTITION BY player ORDER BY played);
+
+-- name: Frame :many
+SELECT player, SUM(points) OVER (ORDER BY played ROWS BETWEEN ? PRECEDING AND CURRENT ROW) AS total
+FROM scores WHERE player = ?;
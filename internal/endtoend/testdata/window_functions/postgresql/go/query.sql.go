@@ -0,0 +1,101 @@
+// Code generated by sqlc. DO NOT EDIT.
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+)
+
+const frame = `-- name: Frame :many
+SELECT player, avg(points) OVER (ORDER BY played ROWS BETWEEN $1 PRECEDING AND $2 FOLLOWING) AS avg
+FROM scores WHERE player = $3
+`
+
+type FrameParams struct {
+	StartOffset int64
+	EndOffset   int64
+	Player      string
+}
+
+type FrameRow struct {
+	Player string
+	Avg    string
+}
+
+func (q *Queries) Frame(ctx context.Context, arg FrameParams) ([]FrameRow, error) {
+	rows, err := q.db.QueryContext(ctx, frame, arg.StartOffset, arg.EndOffset, arg.Player)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FrameRow
+	for rows.Next() {
+		var i FrameRow
+		if err := rows.Scan(&i.Player, &i.Avg); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const ranked = `-- name: Ranked :many
+SELECT player, row_number() OVER (PARTITION BY player ORDER BY points DESC) AS rn,
+  rank() OVER w AS rnk, lag(points) OVER w AS prev, lead(points, 2) OVER w AS next, first_value(played) OVER w AS first,
+  sum(points) OVER w AS running, ntile(4) OVER w AS bucket, percent_rank() OVER w AS pr
+FROM scores
+WINDOW w AS (PARTITION BY player ORDER BY played)
+`
+
+type RankedRow struct {
+	Player  string
+	Rn      int64
+	Rnk     int64
+	Prev    sql.NullInt32
+	Next    sql.NullInt32
+	First   sql.NullTime
+	Running int64
+	Bucket  int32
+	Pr      float64
+}
+
+func (q *Queries) Ranked(ctx context.Context) ([]RankedRow, error) {
+	rows, err := q.db.QueryContext(ctx, ranked)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RankedRow
+	for rows.Next() {
+		var i RankedRow
+		if err := rows.Scan(
+			&i.Player,
+			&i.Rn,
+			&i.Rnk,
+			&i.Prev,
+			&i.Next,
+			&i.First,
+			&i.Running,
+			&i.Bucket,
+			&i.Pr,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,17 @@
+CREATE TABLE scores (
+    id      SERIAL PRIMARY KEY,
+    player  TEXT NOT NULL,
+    points  INTEGER NOT NULL,
+    played  DATE NOT NULL
+);
+
+-- name: Ranked :many
+SELECT player, row_number() OVER (PARTITION BY player ORDER BY points DESC) AS rn,
+  rank() OVER w AS rnk, lag(points) OVER w AS prev, lead(points, 2) OVER w AS next, first_value(played) OVER w AS first,
+  sum(points) OVER w AS running, ntile(4) OVER w AS bucket, percent_rank() OVER w AS pr
+FROM scores
+WINDOW w AS (PARTITION BY player ORDER BY played);
+
+-- name: Frame :many
+SELECT player, avg(points) OVER (ORDER BY played ROWS BETWEEN $1 PRECEDING AND $2 FOLLOWING) AS avg
+FROM scores WHERE player = $3;
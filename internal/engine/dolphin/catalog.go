@@ -5,12 +5,27 @@ import (
 )
 
 func NewCatalog() *catalog.Catalog {
-	def := "public" // TODO: What is the default database for MySQL?
+	// MySQL databases are modeled as schemas. The database queries connect
+	// to, which schema files start in, has no name.
+	def := ""
+	s := defaultSchema(def)
+	s.Operators = operators()
 	return &catalog.Catalog{
 		DefaultSchema: def,
 		Schemas: []*catalog.Schema{
-			defaultSchema(def),
+			s,
 		},
-		Extensions: map[string]struct{}{},
+		Extensions:       map[string]struct{}{},
+		LooseTypes:       true,
+		NullableFuncs:    true,
+		ColumnIndexNames: true,
 	}
 }
+
+// NewMariaDBCatalog returns a catalog that also has the functions MariaDB
+// adds to those of MySQL.
+func NewMariaDBCatalog() *catalog.Catalog {
+	c := NewCatalog()
+	c.Schemas[0].Funcs = append(c.Schemas[0].Funcs, mariadbFuncs()...)
+	return c
+}
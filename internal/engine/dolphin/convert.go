@@ -6,16 +6,21 @@ import (
 	"strings"
 
 	pcast "github.com/pingcap/parser/ast"
+	"github.com/pingcap/parser/mysql"
 	"github.com/pingcap/parser/opcode"
 	driver "github.com/pingcap/parser/test_driver"
 	"github.com/pingcap/parser/types"
 
 	"github.com/kyleconroy/sqlc/internal/debug"
 	"github.com/kyleconroy/sqlc/internal/sql/ast"
+	"github.com/kyleconroy/sqlc/internal/sql/sqlerr"
 )
 
 type cc struct {
 	paramCount int
+
+	// The error for a statement the generic AST can't represent
+	err error
 }
 
 func todo(n pcast.Node) *ast.TODO {
@@ -38,11 +43,7 @@ func (c *cc) convertAlterTableStmt(n *pcast.AlterTableStmt) ast.Node {
 				alt.Cmds.Items = append(alt.Cmds.Items, &ast.AlterTableCmd{
 					Name:    &name,
 					Subtype: ast.AT_AddColumn,
-					Def: &ast.ColumnDef{
-						Colname:   def.Name.String(),
-						TypeName:  &ast.TypeName{Name: types.TypeStr(def.Tp.Tp)},
-						IsNotNull: isNotNull(def),
-					},
+					Def:     convertColumnDef(def),
 				})
 			}
 
@@ -55,41 +56,62 @@ func (c *cc) convertAlterTableStmt(n *pcast.AlterTableStmt) ast.Node {
 			})
 
 		case pcast.AlterTableChangeColumn:
-			// 	spew.Dump("change column", spec)
-
-		case pcast.AlterTableModifyColumn:
+			// CHANGE COLUMN old new ... replaces a column, possibly renaming it
 			for _, def := range spec.NewColumns {
-				name := def.Name.String()
+				name := spec.OldColumnName.String()
 				alt.Cmds.Items = append(alt.Cmds.Items, &ast.AlterTableCmd{
 					Name:    &name,
-					Subtype: ast.AT_DropColumn,
+					Subtype: ast.AT_ChangeColumn,
+					Def:     convertColumnDef(def),
 				})
+			}
+
+		case pcast.AlterTableModifyColumn:
+			for _, def := range spec.NewColumns {
+				name := def.Name.String()
 				alt.Cmds.Items = append(alt.Cmds.Items, &ast.AlterTableCmd{
 					Name:    &name,
-					Subtype: ast.AT_AddColumn,
-					Def: &ast.ColumnDef{
-						Colname:   def.Name.String(),
-						TypeName:  &ast.TypeName{Name: types.TypeStr(def.Tp.Tp)},
-						IsNotNull: isNotNull(def),
-					},
+					Subtype: ast.AT_ChangeColumn,
+					Def:     convertColumnDef(def),
 				})
 			}
 
 		case pcast.AlterTableAlterColumn:
-			// 	spew.Dump("alter column", spec)
+			// ALTER COLUMN only sets or drops a default
 
 		case pcast.AlterTableAddConstraint:
-			// 	spew.Dump("add const", spec)
+			if con := c.convertConstraint(spec.Constraint); con != nil {
+				alt.Cmds.Items = append(alt.Cmds.Items, &ast.AlterTableCmd{
+					Subtype:    ast.AT_AddConstraint,
+					Constraint: con,
+				})
+			}
+
+		case pcast.AlterTableDropPrimaryKey:
+			name := "PRIMARY"
+			alt.Cmds.Items = append(alt.Cmds.Items, &ast.AlterTableCmd{
+				Name:    &name,
+				Subtype: ast.AT_DropConstraint,
+			})
+
+		case pcast.AlterTableDropIndex, pcast.AlterTableDropForeignKey:
+			// Only unique indexes are kept in the catalog, so a missing
+			// index isn't an error
+			name := spec.Name
+			alt.Cmds.Items = append(alt.Cmds.Items, &ast.AlterTableCmd{
+				Name:      &name,
+				Subtype:   ast.AT_DropConstraint,
+				MissingOk: true,
+			})
 
 		case pcast.AlterTableRenameColumn:
-			// TODO: Returning here may be incorrect if there are multiple specs
 			oldName := spec.OldColumnName.String()
 			newName := spec.NewColumnName.String()
-			return &ast.RenameColumnStmt{
-				Table:   parseTableName(n.Table),
-				Col:     &ast.ColumnRef{Name: oldName},
+			alt.Cmds.Items = append(alt.Cmds.Items, &ast.AlterTableCmd{
+				Name:    &oldName,
 				NewName: &newName,
-			}
+				Subtype: ast.AT_RenameColumn,
+			})
 
 		case pcast.AlterTableRenameTable:
 			// TODO: Returning here may be incorrect if there are multiple specs
@@ -119,10 +141,13 @@ func (c *cc) convertAssignment(n *pcast.Assignment) *ast.ResTarget {
 // TODO: These codes should be defined in the sql/lang package
 func opToName(o opcode.Op) string {
 	switch o {
-	// case opcode.And:
-	// case opcode.BitNeg:
+	case opcode.And:
+		return "&"
+	case opcode.BitNeg:
+		return "~"
 	// case opcode.Case:
-	// case opcode.Div:
+	case opcode.Div:
+		return "/"
 	case opcode.EQ:
 		return "="
 	case opcode.GE:
@@ -131,7 +156,7 @@ func opToName(o opcode.Op) string {
 		return ">"
 		// case opcode.In:
 	case opcode.IntDiv:
-		return "/"
+		return "div"
 	// case opcode.IsFalsity:
 	// case opcode.IsNull:
 	// case opcode.IsTruth:
@@ -143,10 +168,11 @@ func opToName(o opcode.Op) string {
 		return "<<"
 		// case opcode.Like:
 	case opcode.LogicAnd:
-		return "&"
+		return "and"
 	case opcode.LogicOr:
-		return "|"
-	// case opcode.LogicXor:
+		return "or"
+	case opcode.LogicXor:
+		return "xor"
 	case opcode.Minus:
 		return "-"
 	case opcode.Mod:
@@ -157,8 +183,10 @@ func opToName(o opcode.Op) string {
 		return "!="
 	case opcode.Not:
 		return "!"
-	// case opcode.NullEQ:
-	// case opcode.Or:
+	case opcode.NullEQ:
+		return "<=>"
+	case opcode.Or:
+		return "|"
 	case opcode.Plus:
 		return "+"
 	case opcode.Regexp:
@@ -166,7 +194,7 @@ func opToName(o opcode.Op) string {
 	case opcode.RightShift:
 		return ">>"
 	case opcode.Xor:
-		return "#"
+		return "^"
 	default:
 		return o.String()
 	}
@@ -191,8 +219,9 @@ func (c *cc) convertBinaryOperationExpr(n *pcast.BinaryOperationExpr) ast.Node {
 					&ast.String{Str: opToName(n.Op)},
 				},
 			},
-			Lexpr: c.convert(n.L),
-			Rexpr: c.convert(n.R),
+			Lexpr:    c.convert(n.L),
+			Rexpr:    c.convert(n.R),
+			Location: n.OriginTextPosition(),
 		}
 	}
 }
@@ -206,31 +235,12 @@ func (c *cc) convertCreateTableStmt(n *pcast.CreateTableStmt) ast.Node {
 		create.ReferTable = parseTableName(n.ReferTable)
 	}
 	for _, def := range n.Cols {
-		var vals *ast.List
-		if len(def.Tp.Elems) > 0 {
-			vals = &ast.List{}
-			for i := range def.Tp.Elems {
-				vals.Items = append(vals.Items, &ast.String{
-					Str: def.Tp.Elems[i],
-				})
-			}
-		}
-		comment := ""
-		for _, opt := range def.Options {
-			switch opt.Tp {
-			case pcast.ColumnOptionComment:
-				if value, ok := opt.Expr.(*driver.ValueExpr); ok {
-					comment = value.GetString()
-				}
-			}
+		create.Cols = append(create.Cols, convertColumnDef(def))
+	}
+	for _, con := range n.Constraints {
+		if constraint := c.convertConstraint(con); constraint != nil {
+			create.Constraints = append(create.Constraints, constraint)
 		}
-		create.Cols = append(create.Cols, &ast.ColumnDef{
-			Colname:   def.Name.String(),
-			TypeName:  &ast.TypeName{Name: types.TypeStr(def.Tp.Tp)},
-			IsNotNull: isNotNull(def),
-			Comment:   comment,
-			Vals:      vals,
-		})
 	}
 	for _, opt := range n.Options {
 		switch opt.Tp {
@@ -241,6 +251,47 @@ func (c *cc) convertCreateTableStmt(n *pcast.CreateTableStmt) ast.Node {
 	return create
 }
 
+// convertColumnDef converts the definition of a column. The values of ENUM
+// and SET columns are kept, so that the catalog can create their types.
+func convertColumnDef(def *pcast.ColumnDef) *ast.ColumnDef {
+	var vals *ast.List
+	if len(def.Tp.Elems) > 0 {
+		vals = &ast.List{}
+		for i := range def.Tp.Elems {
+			vals.Items = append(vals.Items, &ast.String{
+				Str: def.Tp.Elems[i],
+			})
+		}
+	}
+	comment := ""
+	var generated bool
+	for _, opt := range def.Options {
+		switch opt.Tp {
+		case pcast.ColumnOptionComment:
+			if value, ok := opt.Expr.(*driver.ValueExpr); ok {
+				comment = value.GetString()
+			}
+		case pcast.ColumnOptionGenerated:
+			generated = true
+		}
+	}
+	var length *int
+	if flen := def.Tp.Flen; flen != types.UnspecifiedLength {
+		length = &flen
+	}
+	return &ast.ColumnDef{
+		Colname:     def.Name.String(),
+		TypeName:    &ast.TypeName{Name: types.TypeStr(def.Tp.Tp)},
+		IsNotNull:   isNotNull(def),
+		Comment:     comment,
+		Vals:        vals,
+		Unsigned:    mysql.HasUnsignedFlag(def.Tp.Flag),
+		Length:      length,
+		Generated:   generated,
+		Constraints: convertColumnConstraints(def),
+	}
+}
+
 func (c *cc) convertColumnNameExpr(n *pcast.ColumnNameExpr) *ast.ColumnRef {
 	var items []ast.Node
 	if schema := n.Name.Schema.String(); schema != "" {
@@ -269,19 +320,17 @@ func (c *cc) convertColumnNames(cols []*pcast.ColumnName) *ast.List {
 }
 
 func (c *cc) convertDeleteStmt(n *pcast.DeleteStmt) *ast.DeleteStmt {
-	rels := c.convertTableRefsClause(n.TableRefs)
-	if len(rels.Items) != 1 {
-		panic("expected one range var")
-	}
-	rel := rels.Items[0]
-	rangeVar, ok := rel.(*ast.RangeVar)
-	if !ok {
-		panic("expected range var")
+	// A multiple-table DELETE removes rows from the tables it lists, which
+	// can be referenced by their alias in the FROM clause
+	var target string
+	if n.IsMultiTable && n.Tables != nil && len(n.Tables.Tables) > 0 {
+		target = n.Tables.Tables[0].Name.String()
 	}
-
+	rel, using, quals := c.convertMultiTableRefs(n.TableRefs, target)
 	return &ast.DeleteStmt{
-		Relation:      rangeVar,
-		WhereClause:   c.convert(n.Where),
+		Relation:      rel,
+		UsingClause:   using,
+		WhereClause:   joinQuals(quals, c.convert(n.Where)),
 		ReturningList: &ast.List{},
 	}
 }
@@ -383,6 +432,27 @@ func (c *cc) convertInsertStmt(n *pcast.InsertStmt) *ast.InsertStmt {
 			ValuesLists: c.convertLists(n.Lists),
 		}
 	}
+	// INSERT ... SET assigns a single row, like a column list and VALUES
+	if len(n.Setlist) > 0 {
+		row := &ast.List{}
+		for _, a := range n.Setlist {
+			name := a.Column.Name.String()
+			insert.Cols.Items = append(insert.Cols.Items, &ast.ResTarget{Name: &name})
+			row.Items = append(row.Items, c.convert(a.Expr))
+		}
+		values := insert.SelectStmt.(*ast.SelectStmt).ValuesLists
+		values.Items = append(values.Items, row)
+	}
+	if len(n.OnDuplicate) > 0 {
+		targets := &ast.List{}
+		for _, a := range n.OnDuplicate {
+			targets.Items = append(targets.Items, c.convertAssignment(a))
+		}
+		insert.OnConflictClause = &ast.OnConflictClause{
+			Action:     ast.ONCONFLICT_UPDATE,
+			TargetList: targets,
+		}
+	}
 	return insert
 }
 
@@ -436,6 +506,12 @@ func (c *cc) convertSelectStmt(n *pcast.SelectStmt) *ast.SelectStmt {
 		stmt.LimitCount = c.convert(n.Limit.Count)
 		stmt.LimitOffset = c.convert(n.Limit.Offset)
 	}
+	if len(n.WindowSpecs) > 0 {
+		stmt.WindowClause = &ast.List{}
+		for i := range n.WindowSpecs {
+			stmt.WindowClause.Items = append(stmt.WindowClause.Items, c.convertWindowSpec(&n.WindowSpecs[i]))
+		}
+	}
 	return stmt
 }
 
@@ -450,36 +526,189 @@ func (c *cc) convertTableRefsClause(n *pcast.TableRefsClause) *ast.List {
 	return c.convertJoin(n.TableRefs)
 }
 
-func (c *cc) convertUpdateStmt(n *pcast.UpdateStmt) *ast.UpdateStmt {
-	// Relation
-	rels := c.convertTableRefsClause(n.TableRefs)
-	if len(rels.Items) != 1 {
-		panic("expected one range var")
+// convertMultiTableRefs splits the tables of an UPDATE or DELETE statement
+// into the table being modified and the other tables, which become the FROM
+// clause of an UPDATE or the USING clause of a DELETE. The table being
+// modified is the one with the given alias or name. With no name, it's the
+// only table, or nil if there are others, in which case the compiler picks
+// the one with the columns an UPDATE assigns to. The conditions of inner
+// joins are returned so they can be added to the WHERE clause, while outer
+// joins are kept as they are. An outer join that keeps every row of the
+// table being modified is kept with the table in it, in which case the
+// table is both the one returned and an item of the other tables.
+func (c *cc) convertMultiTableRefs(n *pcast.TableRefsClause, name string) (*ast.RangeVar, *ast.List, []ast.Node) {
+	isTarget := func(rv *ast.RangeVar) bool {
+		if name == "" {
+			return false
+		}
+		if rv.Alias != nil {
+			return *rv.Alias.Aliasname == name
+		}
+		return *rv.Relname == name
 	}
-	rel := rels.Items[0]
-	rangeVar, ok := rel.(*ast.RangeVar)
-	if !ok {
-		panic("expected range var")
+	var find func(node ast.Node) *ast.RangeVar
+	find = func(node ast.Node) *ast.RangeVar {
+		switch n := node.(type) {
+		case *ast.List:
+			for _, item := range n.Items {
+				if rv := find(item); rv != nil {
+					return rv
+				}
+			}
+		case *ast.JoinExpr:
+			if rv := find(n.Larg); rv != nil {
+				return rv
+			}
+			return find(n.Rarg)
+		case *ast.RangeVar:
+			if isTarget(n) {
+				return n
+			}
+		}
+		return nil
+	}
+	contains := func(node ast.Node) bool {
+		return find(node) != nil
+	}
+
+	var items, quals []ast.Node
+	var flatten func(node ast.Node)
+	flatten = func(node ast.Node) {
+		switch n := node.(type) {
+		case *ast.List:
+			for _, item := range n.Items {
+				flatten(item)
+			}
+		case *ast.JoinExpr:
+			var preserved ast.Node
+			switch n.Jointype {
+			case ast.JOIN_LEFT:
+				preserved = n.Larg
+			case ast.JOIN_RIGHT:
+				preserved = n.Rarg
+			}
+			if preserved != nil && (!contains(n) || contains(preserved)) {
+				// The columns of the other side are NULL for the rows
+				// without a match, which a WHERE clause can't express
+				items = append(items, n)
+				return
+			}
+			// Only the rows of the table being modified that have a match
+			// are modified, as with an inner join
+			flatten(n.Larg)
+			flatten(n.Rarg)
+			if n.Quals != nil {
+				quals = append(quals, n.Quals)
+			}
+		case nil:
+		default:
+			items = append(items, n)
+		}
+	}
+	flatten(c.convertTableRefsClause(n))
+
+	target := -1
+	for i, item := range items {
+		if rv, ok := item.(*ast.RangeVar); ok && isTarget(rv) {
+			target = i
+			break
+		}
+	}
+	if name == "" && len(items) == 1 {
+		if _, ok := items[0].(*ast.RangeVar); ok {
+			target = 0
+		}
+	}
+	if name != "" && target < 0 {
+		joined := find(&ast.List{Items: items})
+		if joined == nil {
+			c.setErr(sqlerr.RelationNotFound(name))
+		}
+		return joined, &ast.List{Items: items}, quals
+	}
+	rest := &ast.List{}
+	for i, item := range items {
+		if i != target {
+			rest.Items = append(rest.Items, item)
+		}
+	}
+	if target < 0 {
+		return nil, rest, quals
+	}
+	return items[target].(*ast.RangeVar), rest, quals
+}
+
+// setErr records the error of a statement the generic AST can't represent,
+// unless one has already been recorded.
+func (c *cc) setErr(err error) {
+	if c.err == nil {
+		c.err = err
+	}
+}
+
+// joinQuals combines the conditions of a join with a WHERE clause.
+func joinQuals(quals []ast.Node, where ast.Node) ast.Node {
+	if len(quals) == 0 {
+		return where
+	}
+	if where != nil {
+		quals = append(quals, where)
 	}
+	if len(quals) == 1 {
+		return quals[0]
+	}
+	return &ast.BoolExpr{
+		Args: &ast.List{Items: quals},
+	}
+}
+
+func (c *cc) convertUpdateStmt(n *pcast.UpdateStmt) *ast.UpdateStmt {
+	// A multiple-table UPDATE assigns to the table its assignments name.
+	// Without a name, the compiler finds the table by its columns. The
+	// generic AST only has one table to assign to.
+	var target string
+	for _, a := range n.List {
+		if a.Column == nil || a.Column.Table.String() == "" {
+			continue
+		}
+		table := a.Column.Table.String()
+		if target != "" && table != target {
+			c.setErr(&sqlerr.Error{
+				Message:  fmt.Sprintf("an UPDATE that assigns to columns of both %q and %q is not supported", target, table),
+				Location: a.Expr.OriginTextPosition(),
+			})
+			break
+		}
+		target = table
+	}
+	rel, from, quals := c.convertMultiTableRefs(n.TableRefs, target)
+
 	// TargetList
 	list := &ast.List{}
 	for _, a := range n.List {
 		list.Items = append(list.Items, c.convertAssignment(a))
 	}
 	return &ast.UpdateStmt{
-		Relation:      rangeVar,
+		Relation:      rel,
 		TargetList:    list,
-		WhereClause:   c.convert(n.Where),
-		FromClause:    &ast.List{},
+		WhereClause:   joinQuals(quals, c.convert(n.Where)),
+		FromClause:    from,
 		ReturningList: &ast.List{},
 	}
 }
 
 func (c *cc) convertValueExpr(n *driver.ValueExpr) *ast.A_Const {
+	if n.Datum.Kind() == driver.KindNull {
+		return &ast.A_Const{
+			Val:      &ast.Null{},
+			Location: n.OriginTextPosition(),
+		}
+	}
 	return &ast.A_Const{
 		Val: &ast.String{
 			Str: n.Datum.GetString(),
 		},
+		Location: n.OriginTextPosition(),
 	}
 }
 
@@ -604,10 +833,6 @@ func (c *cc) convertCompareSubqueryExpr(n *pcast.CompareSubqueryExpr) ast.Node {
 	return todo(n)
 }
 
-func (c *cc) convertConstraint(n *pcast.Constraint) ast.Node {
-	return todo(n)
-}
-
 func (c *cc) convertCreateBindingStmt(n *pcast.CreateBindingStmt) ast.Node {
 	return todo(n)
 }
@@ -620,7 +845,77 @@ func (c *cc) convertCreateDatabaseStmt(n *pcast.CreateDatabaseStmt) ast.Node {
 }
 
 func (c *cc) convertCreateIndexStmt(n *pcast.CreateIndexStmt) ast.Node {
-	return todo(n)
+	params := &ast.List{}
+	for _, part := range n.IndexPartSpecifications {
+		elem := &ast.IndexElem{}
+		if part.Column != nil {
+			name := part.Column.Name.String()
+			elem.Name = &name
+		} else {
+			elem.Expr = c.convert(part.Expr)
+		}
+		params.Items = append(params.Items, elem)
+	}
+	return &ast.IndexStmt{
+		Idxname:     &n.IndexName,
+		Relation:    c.convertTableName(n.Table),
+		IndexParams: params,
+		Unique:      n.KeyType == pcast.IndexKeyTypeUnique,
+		IfNotExists: n.IfNotExists,
+	}
+}
+
+// convertConstraint converts the primary key, unique and foreign key
+// constraints of a table, which are the ones the catalog keeps track of.
+// Other indexes don't affect the types of queries.
+//
+// https://dev.mysql.com/doc/refman/8.0/en/create-table.html
+func (c *cc) convertConstraint(n *pcast.Constraint) *ast.Constraint {
+	var keys []ast.Node
+	for _, key := range n.Keys {
+		if key.Column != nil {
+			keys = append(keys, &ast.String{Str: key.Column.Name.String()})
+		}
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	con := &ast.Constraint{
+		Keys: &ast.List{Items: keys},
+	}
+	switch n.Tp {
+	case pcast.ConstraintPrimaryKey:
+		// The primary key of a MySQL table is always named PRIMARY
+		name := "PRIMARY"
+		con.Contype = ast.CONSTR_PRIMARY
+		con.Conname = &name
+	case pcast.ConstraintUniq, pcast.ConstraintUniqKey, pcast.ConstraintUniqIndex:
+		// The catalog names an unnamed unique index after its first column
+		con.Contype = ast.CONSTR_UNIQUE
+		if n.Name != "" {
+			con.Conname = &n.Name
+		}
+	case pcast.ConstraintForeignKey:
+		con.Contype = ast.CONSTR_FOREIGN
+		con.FkAttrs = con.Keys
+		con.Keys = nil
+		if n.Name != "" {
+			con.Conname = &n.Name
+		}
+		if n.Refer != nil {
+			con.Pktable = c.convertTableName(n.Refer.Table)
+			var refs []ast.Node
+			for _, part := range n.Refer.IndexPartSpecifications {
+				if part.Column != nil {
+					refs = append(refs, &ast.String{Str: part.Column.Name.String()})
+				}
+			}
+			con.PkAttrs = &ast.List{Items: refs}
+		}
+	default:
+		return nil
+	}
+	return con
 }
 
 func (c *cc) convertCreateSequenceStmt(n *pcast.CreateSequenceStmt) ast.Node {
@@ -644,7 +939,11 @@ func (c *cc) convertDeallocateStmt(n *pcast.DeallocateStmt) ast.Node {
 }
 
 func (c *cc) convertDefaultExpr(n *pcast.DefaultExpr) ast.Node {
-	return todo(n)
+	// DEFAULT(col) is the default value of another column
+	if n.Name != nil {
+		return todo(n)
+	}
+	return &ast.SetToDefault{}
 }
 
 func (c *cc) convertDeleteTableList(n *pcast.DeleteTableList) ast.Node {
@@ -661,7 +960,7 @@ func (c *cc) convertDropBindingStmt(n *pcast.DropBindingStmt) ast.Node {
 
 func (c *cc) convertDropDatabaseStmt(n *pcast.DropDatabaseStmt) ast.Node {
 	return &ast.DropSchemaStmt{
-		MissingOk: !n.IfExists,
+		MissingOk: n.IfExists,
 		Schemas: []*ast.String{
 			{Str: n.Name},
 		},
@@ -708,8 +1007,13 @@ func (c *cc) convertFlushStmt(n *pcast.FlushStmt) ast.Node {
 	return todo(n)
 }
 
+// convertFrameBound returns the offset of a frame bound, such as the 3 in
+// `3 PRECEDING`, or nil for UNBOUNDED and CURRENT ROW bounds.
 func (c *cc) convertFrameBound(n *pcast.FrameBound) ast.Node {
-	return todo(n)
+	if n.UnBounded || n.Type == pcast.CurrentRow || n.Expr == nil {
+		return nil
+	}
+	return c.convert(n.Expr)
 }
 
 func (c *cc) convertFrameClause(n *pcast.FrameClause) ast.Node {
@@ -765,12 +1069,19 @@ func (c *cc) convertJoin(n *pcast.Join) *ast.List {
 		return &ast.List{}
 	}
 	if n.Right != nil && n.Left != nil {
+		join := &ast.JoinExpr{
+			Larg:  c.convert(n.Left),
+			Rarg:  c.convert(n.Right),
+			Quals: c.convert(n.On),
+		}
+		switch n.Tp {
+		case pcast.LeftJoin:
+			join.Jointype = ast.JOIN_LEFT
+		case pcast.RightJoin:
+			join.Jointype = ast.JOIN_RIGHT
+		}
 		return &ast.List{
-			Items: []ast.Node{&ast.JoinExpr{
-				Larg:  c.convert(n.Left),
-				Rarg:  c.convert(n.Right),
-				Quals: c.convert(n.On),
-			}},
+			Items: []ast.Node{join},
 		}
 	}
 	var tables []ast.Node
@@ -800,7 +1111,11 @@ func (c *cc) convertLoadStatsStmt(n *pcast.LoadStatsStmt) ast.Node {
 }
 
 func (c *cc) convertLockTablesStmt(n *pcast.LockTablesStmt) ast.Node {
-	return todo(n)
+	rels := &ast.List{}
+	for _, lock := range n.TableLocks {
+		rels.Items = append(rels.Items, c.convertTableName(lock.Table))
+	}
+	return &ast.LockStmt{Relations: rels}
 }
 
 func (c *cc) convertMatchAgainst(n *pcast.MatchAgainst) ast.Node {
@@ -925,8 +1240,15 @@ func (c *cc) convertSetRoleStmt(n *pcast.SetRoleStmt) ast.Node {
 	return todo(n)
 }
 
+// convertSetStmt converts a SET statement, which can assign several
+// variables at once. Each assignment is a target in the statement's
+// arguments.
 func (c *cc) convertSetStmt(n *pcast.SetStmt) ast.Node {
-	return todo(n)
+	args := &ast.List{}
+	for _, v := range n.Variables {
+		args.Items = append(args.Items, c.convertVariableAssignment(v))
+	}
+	return &ast.VariableSetStmt{Args: args}
 }
 
 func (c *cc) convertShowStmt(n *pcast.ShowStmt) ast.Node {
@@ -1009,7 +1331,19 @@ func (c *cc) convertTruncateTableStmt(n *pcast.TruncateTableStmt) *ast.TruncateS
 }
 
 func (c *cc) convertUnaryOperationExpr(n *pcast.UnaryOperationExpr) ast.Node {
-	return todo(n)
+	switch n.Op {
+	case opcode.Plus, opcode.Minus, opcode.BitNeg:
+		return &ast.A_Expr{
+			Name: &ast.List{
+				Items: []ast.Node{
+					&ast.String{Str: opToName(n.Op)},
+				},
+			},
+			Rexpr: c.convert(n.V),
+		}
+	default:
+		return todo(n)
+	}
 }
 
 func (c *cc) convertUnlockTablesStmt(n *pcast.UnlockTablesStmt) ast.Node {
@@ -1017,15 +1351,31 @@ func (c *cc) convertUnlockTablesStmt(n *pcast.UnlockTablesStmt) ast.Node {
 }
 
 func (c *cc) convertUseStmt(n *pcast.UseStmt) ast.Node {
-	return todo(n)
+	return &ast.UseStmt{
+		Schema: n.DBName,
+	}
 }
 
+// convertValuesExpr converts VALUES(col) in an ON DUPLICATE KEY UPDATE
+// clause, the value that would have been inserted into col, to the
+// equivalent reference to the EXCLUDED row used by PostgreSQL.
 func (c *cc) convertValuesExpr(n *pcast.ValuesExpr) ast.Node {
-	return todo(n)
+	return &ast.ColumnRef{
+		Fields: &ast.List{
+			Items: []ast.Node{
+				&ast.String{Str: "excluded"},
+				&ast.String{Str: n.Column.Name.Name.String()},
+			},
+		},
+	}
 }
 
 func (c *cc) convertVariableAssignment(n *pcast.VariableAssignment) ast.Node {
-	return todo(n)
+	name := n.Name
+	return &ast.ResTarget{
+		Name: &name,
+		Val:  c.convert(n.Value),
+	}
 }
 
 func (c *cc) convertVariableExpr(n *pcast.VariableExpr) ast.Node {
@@ -1037,11 +1387,62 @@ func (c *cc) convertWhenClause(n *pcast.WhenClause) ast.Node {
 }
 
 func (c *cc) convertWindowFuncExpr(n *pcast.WindowFuncExpr) ast.Node {
-	return todo(n)
+	name := strings.ToLower(n.F)
+	fn := &ast.FuncCall{
+		Func: &ast.FuncName{
+			Name: name,
+		},
+		Funcname: &ast.List{
+			Items: []ast.Node{
+				&ast.String{
+					Str: name,
+				},
+			},
+		},
+		Args:        &ast.List{},
+		AggOrder:    &ast.List{},
+		AggDistinct: n.Distinct,
+		Over:        c.convertWindowSpec(&n.Spec),
+	}
+	for _, a := range n.Args {
+		fn.Args.Items = append(fn.Args.Items, c.convert(a))
+	}
+	return fn
 }
 
-func (c *cc) convertWindowSpec(n *pcast.WindowSpec) ast.Node {
-	return todo(n)
+func (c *cc) convertWindowSpec(n *pcast.WindowSpec) *ast.WindowDef {
+	def := &ast.WindowDef{
+		PartitionClause: &ast.List{},
+		OrderClause:     &ast.List{},
+	}
+	// OVER w refers to a window defined in the WINDOW clause
+	if n.OnlyAlias {
+		def.Refname = &n.Name.O
+		return def
+	}
+	if n.Name.O != "" {
+		def.Name = &n.Name.O
+	}
+	if n.Ref.O != "" {
+		def.Refname = &n.Ref.O
+	}
+	if n.PartitionBy != nil {
+		for _, item := range n.PartitionBy.Items {
+			def.PartitionClause.Items = append(def.PartitionClause.Items, c.convert(item.Expr))
+		}
+	}
+	if n.OrderBy != nil {
+		for _, item := range n.OrderBy.Items {
+			def.OrderClause.Items = append(def.OrderClause.Items, &ast.SortBy{
+				Node: c.convert(item.Expr),
+			})
+		}
+	}
+	if n.Frame != nil {
+		def.StartOffset = c.convertFrameBound(&n.Frame.Extent.Start)
+		def.EndOffset = c.convertFrameBound(&n.Frame.Extent.End)
+	}
+	return def
 }
 
 func (c *cc) convert(node pcast.Node) ast.Node {
@@ -1126,7 +1527,10 @@ func (c *cc) convert(node pcast.Node) ast.Node {
 		return c.convertCompareSubqueryExpr(n)
 
 	case *pcast.Constraint:
-		return c.convertConstraint(n)
+		if con := c.convertConstraint(n); con != nil {
+			return con
+		}
+		return todo(n)
 
 	case *pcast.CreateBindingStmt:
 		return c.convertCreateBindingStmt(n)
@@ -0,0 +1,422 @@
+package dolphin
+
+import (
+	"strings"
+
+	"github.com/pingcap/parser"
+	pcast "github.com/pingcap/parser/ast"
+
+	"github.com/kyleconroy/sqlc/internal/sql/ast"
+	"github.com/kyleconroy/sqlc/internal/sql/astutils"
+	"github.com/kyleconroy/sqlc/internal/sql/catalog"
+)
+
+// The MySQL parser doesn't know about the parts of the MariaDB grammar
+// that MySQL lacks. Before parsing, they're blanked out of the text, which
+// keeps the offsets of everything else, and they're added back to the
+// converted statements.
+//
+// https://mariadb.com/kb/en/incompatibilities-and-feature-differences-between-mariadb-and-mysql-unmaint/
+
+// MariaDB column types, which are parsed as TEXT.
+//
+// https://mariadb.com/kb/en/uuid-data-type/
+// https://mariadb.com/kb/en/inet6/
+var mariadbTypes = map[string]bool{
+	"uuid":  true,
+	"inet4": true,
+	"inet6": true,
+}
+
+// A mariadbExtension is a part of a statement that was blanked out before
+// parsing. It's either a RETURNING clause or the type of a column.
+type mariadbExtension struct {
+	pos int
+
+	// The text of a RETURNING clause of an INSERT, REPLACE or DELETE
+	// statement
+	returning string
+
+	// A column declared with one of the MariaDB types
+	column   string
+	dataType string
+}
+
+type mariadbToken struct {
+	text string
+	pos  int
+}
+
+func (t mariadbToken) is(word string) bool {
+	return strings.EqualFold(t.text, word)
+}
+
+func (t mariadbToken) end() int {
+	return t.pos + len(t.text)
+}
+
+// identifier returns the name of the identifier a token is, if any.
+func (p *Parser) identifier(t mariadbToken) (string, bool) {
+	if strings.HasPrefix(t.text, "`") {
+		return strings.Trim(t.text, "`"), true
+	}
+	if !isWordByte(t.text[0]) || p.IsReservedKeyword(t.text) {
+		return "", false
+	}
+	return t.text, true
+}
+
+func isWordByte(b byte) bool {
+	return b == '_' || b == '$' || b >= 0x80 ||
+		('a' <= b && b <= 'z') || ('A' <= b && b <= 'Z') || ('0' <= b && b <= '9')
+}
+
+// tokenizeMariaDB splits the text of a file into statements of words,
+// quoted strings and identifiers, and punctuation. Comments are skipped.
+func tokenizeMariaDB(src string) [][]mariadbToken {
+	var stmts [][]mariadbToken
+	var stmt []mariadbToken
+	for i := 0; i < len(src); {
+		b := src[i]
+		switch {
+		case b == ' ' || b == '\t' || b == '\n' || b == '\r':
+			i++
+
+		case b == '#' || strings.HasPrefix(src[i:], "--") && (i+2 == len(src) || strings.ContainsAny(src[i+2:i+3], " \t\r\n")):
+			end := strings.IndexByte(src[i:], '\n')
+			if end < 0 {
+				i = len(src)
+			} else {
+				i += end + 1
+			}
+
+		case strings.HasPrefix(src[i:], "/*"):
+			end := strings.Index(src[i+2:], "*/")
+			if end < 0 {
+				i = len(src)
+			} else {
+				i += end + 4
+			}
+
+		case b == '\'' || b == '"' || b == '`':
+			j := i + 1
+			for j < len(src) {
+				if src[j] == '\\' && b != '`' {
+					j += 2
+					continue
+				}
+				if src[j] == b {
+					// A quote is escaped by doubling it
+					if j+1 < len(src) && src[j+1] == b {
+						j += 2
+						continue
+					}
+					break
+				}
+				j++
+			}
+			if j >= len(src) {
+				j = len(src) - 1
+			}
+			stmt = append(stmt, mariadbToken{text: src[i : j+1], pos: i})
+			i = j + 1
+
+		case isWordByte(b):
+			j := i
+			for j < len(src) && isWordByte(src[j]) {
+				j++
+			}
+			stmt = append(stmt, mariadbToken{text: src[i:j], pos: i})
+			i = j
+
+		case b == ';':
+			if len(stmt) > 0 {
+				stmts = append(stmts, stmt)
+			}
+			stmt = nil
+			i++
+
+		default:
+			stmt = append(stmt, mariadbToken{text: src[i : i+1], pos: i})
+			i++
+		}
+	}
+	if len(stmt) > 0 {
+		stmts = append(stmts, stmt)
+	}
+	return stmts
+}
+
+// blank replaces the text between two offsets with spaces, keeping line
+// breaks so that the parser reports the right lines.
+func blank(buf []byte, start, end int) {
+	for i := start; i < end; i++ {
+		if buf[i] != '\n' {
+			buf[i] = ' '
+		}
+	}
+}
+
+// stripMariaDB blanks out the parts of the text the MySQL parser would
+// reject and returns them.
+func (p *Parser) stripMariaDB(src string) (string, []mariadbExtension) {
+	buf := []byte(src)
+	var exts []mariadbExtension
+	for _, stmt := range tokenizeMariaDB(src) {
+		switch {
+		case stmt[0].is("INSERT") || stmt[0].is("REPLACE") || stmt[0].is("DELETE"):
+			// https://mariadb.com/kb/en/insertreturning/
+			// https://mariadb.com/kb/en/deletereturning/
+			depth := 0
+			for _, t := range stmt {
+				switch {
+				case t.text == "(":
+					depth++
+				case t.text == ")":
+					depth--
+				case depth == 0 && t.is("RETURNING"):
+					end := stmt[len(stmt)-1].end()
+					exts = append(exts, mariadbExtension{
+						pos:       t.pos,
+						returning: src[t.pos:end],
+					})
+					blank(buf, t.pos, end)
+				}
+			}
+
+		case stmt[0].is("CREATE") || stmt[0].is("ALTER"):
+			// A type follows the name of a column. Functions, like UUID(),
+			// are followed by their arguments, and a column can itself be
+			// named uuid.
+			for i := 1; i < len(stmt); i++ {
+				t := stmt[i]
+				if !mariadbTypes[strings.ToLower(t.text)] {
+					continue
+				}
+				if i+1 < len(stmt) && (stmt[i+1].text == "(" || mariadbTypes[strings.ToLower(stmt[i+1].text)]) {
+					continue
+				}
+				column, ok := p.identifier(stmt[i-1])
+				if !ok {
+					continue
+				}
+				exts = append(exts, mariadbExtension{
+					pos:      t.pos,
+					column:   column,
+					dataType: strings.ToLower(t.text),
+				})
+				blank(buf, t.pos, t.end())
+				copy(buf[t.pos:], "TEXT")
+			}
+		}
+	}
+	return string(buf), exts
+}
+
+// applyMariaDB adds the parts of the statement between the two offsets that
+// were blanked out before parsing back to its converted form.
+func (c *cc) applyMariaDB(stmt ast.Node, src string, exts []mariadbExtension, start, end int) error {
+	for _, ext := range exts {
+		if ext.pos < start || ext.pos >= end {
+			continue
+		}
+		if ext.returning != "" {
+			list, err := c.convertReturning(src, ext)
+			if err != nil {
+				return err
+			}
+			switch n := stmt.(type) {
+			case *ast.InsertStmt:
+				n.ReturningList.Items = append(n.ReturningList.Items, list.Items...)
+			case *ast.DeleteStmt:
+				n.ReturningList.Items = append(n.ReturningList.Items, list.Items...)
+			}
+			continue
+		}
+		astutils.Walk(astutils.VisitorFunc(func(node ast.Node) {
+			if def, ok := node.(*ast.ColumnDef); ok && strings.EqualFold(def.Colname, ext.column) {
+				def.TypeName = &ast.TypeName{Name: ext.dataType}
+			}
+		}), stmt)
+	}
+	return nil
+}
+
+// convertReturning parses the columns of a RETURNING clause as those of a
+// SELECT statement at the same offset, so that the locations of its nodes
+// point into the original text.
+func (c *cc) convertReturning(src string, ext mariadbExtension) (*ast.List, error) {
+	prefix := []byte(src[:ext.pos])
+	blank(prefix, 0, len(prefix))
+	// SELECT and the spaces after it take the place of RETURNING
+	sel := string(prefix) + "SELECT   " + ext.returning[len("RETURNING"):]
+	// The statements of the file are still being converted, so they can't
+	// be parsed again by the same parser
+	stmtNodes, _, err := parser.New().Parse(sel, "", "")
+	if err != nil {
+		return nil, normalizeErr(err, sel)
+	}
+	list := &ast.List{}
+	if len(stmtNodes) != 1 {
+		return list, nil
+	}
+	if n, ok := stmtNodes[0].(*pcast.SelectStmt); ok && n.Fields != nil {
+		for _, field := range n.Fields.Fields {
+			list.Items = append(list.Items, c.convertSelectField(field))
+		}
+	}
+	return list, nil
+}
+
+// mariadbFuncs returns the functions MariaDB adds to those of MySQL.
+//
+// https://mariadb.com/kb/en/sequence-functions/
+// https://mariadb.com/kb/en/json-functions/
+func mariadbFuncs() []*catalog.Function {
+	return []*catalog.Function{
+		{
+			Name: "JSON_COMPACT",
+			Args: []*catalog.Argument{
+				{
+					Type: &ast.TypeName{Name: "json"},
+				},
+			},
+			ReturnType: &ast.TypeName{Name: "json"},
+		},
+		{
+			Name: "JSON_DETAILED",
+			Args: []*catalog.Argument{
+				{
+					Type: &ast.TypeName{Name: "json"},
+				},
+				{
+					Type:       &ast.TypeName{Name: "int"},
+					HasDefault: true,
+				},
+			},
+			ReturnType: &ast.TypeName{Name: "json"},
+		},
+		{
+			Name: "JSON_EQUALS",
+			Args: []*catalog.Argument{
+				{
+					Type: &ast.TypeName{Name: "json"},
+				},
+				{
+					Type: &ast.TypeName{Name: "json"},
+				},
+			},
+			ReturnType: &ast.TypeName{Name: "bool"},
+		},
+		{
+			Name: "JSON_EXISTS",
+			Args: []*catalog.Argument{
+				{
+					Type: &ast.TypeName{Name: "json"},
+				},
+				{
+					Type: &ast.TypeName{Name: "text"},
+				},
+			},
+			ReturnType: &ast.TypeName{Name: "bool"},
+		},
+		{
+			Name: "JSON_LOOSE",
+			Args: []*catalog.Argument{
+				{
+					Type: &ast.TypeName{Name: "json"},
+				},
+			},
+			ReturnType: &ast.TypeName{Name: "json"},
+		},
+		{
+			Name: "JSON_NORMALIZE",
+			Args: []*catalog.Argument{
+				{
+					Type: &ast.TypeName{Name: "json"},
+				},
+			},
+			ReturnType: &ast.TypeName{Name: "json"},
+		},
+		{
+			Name: "JSON_QUERY",
+			Args: []*catalog.Argument{
+				{
+					Type: &ast.TypeName{Name: "json"},
+				},
+				{
+					Type: &ast.TypeName{Name: "text"},
+				},
+			},
+			ReturnType: &ast.TypeName{Name: "json"},
+		},
+		{
+			Name: "LASTVAL",
+			Args: []*catalog.Argument{
+				{
+					Type: &ast.TypeName{Name: "any"},
+				},
+			},
+			ReturnType:         &ast.TypeName{Name: "bigint"},
+			ReturnTypeNullable: true,
+		},
+		{
+			Name: "NATURAL_SORT_KEY",
+			Args: []*catalog.Argument{
+				{
+					Type: &ast.TypeName{Name: "text"},
+				},
+			},
+			ReturnType: &ast.TypeName{Name: "text"},
+		},
+		{
+			Name: "NEXTVAL",
+			Args: []*catalog.Argument{
+				{
+					Type: &ast.TypeName{Name: "any"},
+				},
+			},
+			ReturnType: &ast.TypeName{Name: "bigint"},
+		},
+		{
+			Name: "SETVAL",
+			Args: []*catalog.Argument{
+				{
+					Type: &ast.TypeName{Name: "any"},
+				},
+				{
+					Type: &ast.TypeName{Name: "bigint"},
+				},
+				{
+					Type:       &ast.TypeName{Name: "bool"},
+					HasDefault: true,
+				},
+				{
+					Type:       &ast.TypeName{Name: "bigint"},
+					HasDefault: true,
+				},
+			},
+			ReturnType:         &ast.TypeName{Name: "bigint"},
+			ReturnTypeNullable: true,
+		},
+		{
+			Name: "SFORMAT",
+			Args: []*catalog.Argument{
+				{
+					Type: &ast.TypeName{Name: "text"},
+				},
+				{
+					Type: &ast.TypeName{Name: "any"},
+					Mode: ast.FuncParamVariadic,
+				},
+			},
+			ReturnType: &ast.TypeName{Name: "text"},
+		},
+		{
+			Name:       "SYS_GUID",
+			Args:       []*catalog.Argument{},
+			ReturnType: &ast.TypeName{Name: "text"},
+			Strict:     true,
+		},
+	}
+}
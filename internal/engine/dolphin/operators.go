@@ -0,0 +1,72 @@
+package dolphin
+
+import (
+	"github.com/kyleconroy/sqlc/internal/sql/ast"
+	"github.com/kyleconroy/sqlc/internal/sql/catalog"
+)
+
+// MySQL converts the operands of an operator as needed, so the operators
+// below only describe the result types of the conversions it performs.
+//
+// https://dev.mysql.com/doc/refman/8.0/en/arithmetic-functions.html
+// https://dev.mysql.com/doc/refman/8.0/en/type-conversion.html
+func operators() []*catalog.Operator {
+	var ops []*catalog.Operator
+	binary := func(name, left, right, ret string) {
+		ops = append(ops, &catalog.Operator{
+			Name:       name,
+			Left:       &ast.TypeName{Name: left},
+			Right:      &ast.TypeName{Name: right},
+			ReturnType: &ast.TypeName{Name: ret},
+		})
+	}
+	prefix := func(name, right, ret string) {
+		ops = append(ops, &catalog.Operator{
+			Name:       name,
+			Right:      &ast.TypeName{Name: right},
+			ReturnType: &ast.TypeName{Name: ret},
+		})
+	}
+
+	// Comparisons evaluate to 1 (TRUE), 0 (FALSE), or NULL
+	//
+	// https://dev.mysql.com/doc/refman/8.0/en/comparison-operators.html
+	for _, name := range []string{"=", "<=>", "!=", "<>", "<", "<=", ">", ">=", "~"} {
+		binary(name, "any", "any", "bool")
+	}
+
+	// Logical operators evaluate to 1 (TRUE), 0 (FALSE), or NULL
+	//
+	// https://dev.mysql.com/doc/refman/8.0/en/logical-operators.html
+	for _, name := range []string{"and", "or", "xor"} {
+		binary(name, "any", "any", "bool")
+	}
+
+	// Integer arithmetic is performed with BIGINT precision, while exact-value
+	// numbers are promoted to DECIMAL and approximate-value ones to DOUBLE
+	for _, typ := range []string{"tinyint", "smallint", "mediumint", "int", "bigint"} {
+		for _, name := range []string{"+", "-", "*", "%"} {
+			binary(name, typ, typ, "bigint")
+		}
+		binary("/", typ, typ, "decimal")
+		prefix("-", typ, "bigint")
+	}
+	for _, name := range []string{"+", "-", "*", "/", "%"} {
+		binary(name, "decimal", "decimal", "decimal")
+		binary(name, "double", "double", "double")
+	}
+	prefix("-", "decimal", "decimal")
+	prefix("-", "double", "double")
+	binary("div", "bigint", "bigint", "bigint")
+
+	// Bit functions and operators return BIGINT UNSIGNED values, but operator
+	// results carry no sign, so they're typed as BIGINT
+	//
+	// https://dev.mysql.com/doc/refman/8.0/en/bit-functions.html
+	for _, name := range []string{"&", "|", "^", "<<", ">>"} {
+		binary(name, "bigint", "bigint", "bigint")
+	}
+	prefix("~", "bigint", "bigint")
+
+	return ops
+}
@@ -17,16 +17,29 @@ import (
 )
 
 func NewParser() *Parser {
-	return &Parser{parser.New()}
+	return &Parser{pingcap: parser.New()}
+}
+
+// NewMariaDBParser returns a parser for the MariaDB dialect, which adds
+// RETURNING clauses and a few column types to the MySQL grammar.
+func NewMariaDBParser() *Parser {
+	return &Parser{pingcap: parser.New(), mariadb: true}
 }
 
 type Parser struct {
 	pingcap *parser.Parser
+	mariadb bool
 }
 
 var lineColumn = regexp.MustCompile(`^line (\d+) column (\d+) (.*)`)
 
-func normalizeErr(err error) error {
+// The parser quotes the rest of the text from the token it failed on,
+// cutting it short and adding its total length if it's too long.
+var nearText = regexp.MustCompile(`(?s) near "(.*)"[^"]*?(?: \(total length (\d+)\))?$`)
+
+// normalizeErr turns an error from parsing src into a syntax error at the
+// offset of the token the parser failed on.
+func normalizeErr(err error, src string) error {
 	if err == nil {
 		return err
 	}
@@ -39,42 +52,103 @@ func normalizeErr(err error) error {
 		if lineErr != nil || colErr != nil {
 			return errors.New(msg)
 		}
-		return &sqlerr.Error{
+		serr := &sqlerr.Error{
 			Message: "syntax error",
 			Err:     errors.New(out[3]),
 			Line:    line,
 			Column:  col,
 		}
+		// The line and column are those of the end of the token, so the
+		// token is found from the quoted text instead
+		if loc, ok := errOffset(err.Error(), src); ok {
+			serr.Location = loc
+			serr.Line, serr.Column = position(src, loc)
+		}
+		return serr
 	}
 	return errors.New(msg)
 }
 
+// errOffset returns the offset in src of the text quoted by an error.
+func errOffset(msg, src string) (int, bool) {
+	out := nearText.FindStringSubmatch(msg)
+	if len(out) != 3 || out[1] == "" {
+		return 0, false
+	}
+	n := len(out[1])
+	if out[2] != "" {
+		total, err := strconv.Atoi(out[2])
+		if err != nil {
+			return 0, false
+		}
+		n = total
+	} else if !strings.HasSuffix(src, out[1]) {
+		return 0, false
+	}
+	if n > len(src) {
+		return 0, false
+	}
+	return len(src) - n, true
+}
+
+// position returns the line and column, counting from one, of an offset.
+func position(src string, loc int) (int, int) {
+	line := 1 + strings.Count(src[:loc], "\n")
+	return line, loc - strings.LastIndex(src[:loc], "\n")
+}
+
 func (p *Parser) Parse(r io.Reader) ([]ast.Statement, error) {
 	blob, err := ioutil.ReadAll(r)
 	if err != nil {
 		return nil, err
 	}
-	stmtNodes, _, err := p.pingcap.Parse(string(blob), "", "")
+	src := string(blob)
+	var exts []mariadbExtension
+	if p.mariadb {
+		src, exts = p.stripMariaDB(src)
+	}
+	stmtNodes, _, err := p.pingcap.Parse(src, "", "")
 	if err != nil {
-		return nil, normalizeErr(err)
+		return nil, normalizeErr(err, src)
 	}
 	var stmts []ast.Statement
+	// The text of each statement starts where the text of the one before it
+	// ends, after the semicolon, and the parser drops a line break from
+	// either end
+	var end int
 	for i := range stmtNodes {
+		text := stmtNodes[i].Text()
+		loc := end
+		if loc < len(src) && src[loc] == '\n' {
+			loc++
+		}
+		end = loc + len(text)
+
 		converter := &cc{}
 		out := converter.convert(stmtNodes[i])
+		if converter.err != nil {
+			return nil, converter.err
+		}
 		if _, ok := out.(*ast.TODO); ok {
 			continue
 		}
 
-		// TODO: Attach the text directly to the ast.Statement node
-		text := stmtNodes[i].Text()
-		loc := strings.Index(string(blob), text)
+		if p.mariadb {
+			if err := converter.applyMariaDB(out, src, exts, loc, end); err != nil {
+				return nil, err
+			}
+		}
 
+		// The last statement of a file doesn't need a semicolon
+		stmtLen := len(strings.TrimRight(text, " \t\r\n"))
+		if strings.HasSuffix(text[:stmtLen], ";") {
+			stmtLen--
+		}
 		stmts = append(stmts, ast.Statement{
 			Raw: &ast.RawStmt{
 				Stmt:         out,
 				StmtLocation: loc,
-				StmtLen:      len(text) - 1, // Subtract one to remove semicolon
+				StmtLen:      stmtLen,
 			},
 		})
 	}
@@ -85,6 +85,22 @@ func toList(node pcast.Node) *ast.List {
 	return &ast.List{Items: items}
 }
 
+// convertColumnConstraints returns the PRIMARY KEY and UNIQUE options of a
+// column as constraints.
+func convertColumnConstraints(n *pcast.ColumnDef) *ast.List {
+	list := &ast.List{}
+	for _, opt := range n.Options {
+		switch opt.Tp {
+		case pcast.ColumnOptionPrimaryKey:
+			name := "PRIMARY"
+			list.Items = append(list.Items, &ast.Constraint{Contype: ast.CONSTR_PRIMARY, Conname: &name})
+		case pcast.ColumnOptionUniqKey:
+			list.Items = append(list.Items, &ast.Constraint{Contype: ast.CONSTR_UNIQUE})
+		}
+	}
+	return list
+}
+
 func isNotNull(n *pcast.ColumnDef) bool {
 	for i := range n.Options {
 		if n.Options[i].Tp == pcast.ColumnOptionNotNull {
@@ -5,7 +5,10 @@ import "github.com/kyleconroy/sqlc/internal/sql/catalog"
 func NewCatalog() *catalog.Catalog {
 	c := catalog.New("public")
 	c.Schemas = append(c.Schemas, pgTemp())
-	c.Schemas = append(c.Schemas, genPGCatalog())
+	pg := genPGCatalog()
+	pg.Operators = pgOperators()
+	pg.Types = append(pg.Types, pgTypes()...)
+	c.Schemas = append(c.Schemas, pg)
 	c.SearchPath = []string{"pg_catalog"}
 	c.LoadExtension = loadExtension
 	return c
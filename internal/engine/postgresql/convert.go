@@ -54,10 +54,15 @@ func convertA_Expr(n *nodes.A_Expr) *ast.A_Expr {
 	if n == nil {
 		return nil
 	}
+	// Prefix operators have no left operand
+	var lexpr ast.Node
+	if n.Lexpr != nil {
+		lexpr = convertNode(n.Lexpr)
+	}
 	return &ast.A_Expr{
 		Kind:     ast.A_Expr_Kind(n.Kind),
 		Name:     convertList(n.Name),
-		Lexpr:    convertNode(n.Lexpr),
+		Lexpr:    lexpr,
 		Rexpr:    convertNode(n.Rexpr),
 		Location: n.Location,
 	}
@@ -180,9 +185,13 @@ func convertAlterDomainStmt(n *nodes.AlterDomainStmt) *ast.AlterDomainStmt {
 	if n == nil {
 		return nil
 	}
+	tn, err := parseTypeName(n.TypeName)
+	if err != nil {
+		panic(err)
+	}
 	return &ast.AlterDomainStmt{
 		Subtype:   n.Subtype,
-		TypeName:  convertList(n.TypeName),
+		TypeName:  tn,
 		Name:      n.Name,
 		Def:       convertNode(n.Def),
 		Behavior:  ast.DropBehavior(n.Behavior),
@@ -954,9 +963,18 @@ func convertCreateDomainStmt(n *nodes.CreateDomainStmt) *ast.CreateDomainStmt {
 	if n == nil {
 		return nil
 	}
+	name, err := parseTypeName(n.Domainname)
+	if err != nil {
+		panic(err)
+	}
+	tn, err := parseTypeName(n.TypeName)
+	if err != nil {
+		panic(err)
+	}
 	return &ast.CreateDomainStmt{
-		Domainname:  convertList(n.Domainname),
-		TypeName:    convertTypeName(n.TypeName),
+		Domainname:  name,
+		TypeName:    tn,
+		IsArray:     isArray(n.TypeName),
 		CollClause:  convertCollateClause(n.CollClause),
 		Constraints: convertList(n.Constraints),
 	}
@@ -1136,10 +1154,31 @@ func convertCreateRangeStmt(n *nodes.CreateRangeStmt) *ast.CreateRangeStmt {
 	if n == nil {
 		return nil
 	}
-	return &ast.CreateRangeStmt{
-		TypeName: convertList(n.TypeName),
+	tn, err := parseTypeName(n.TypeName)
+	if err != nil {
+		panic(err)
+	}
+	stmt := &ast.CreateRangeStmt{
+		TypeName: tn,
 		Params:   convertList(n.Params),
 	}
+	for _, item := range n.Params.Items {
+		def, ok := item.(nodes.DefElem)
+		if !ok || def.Defname == nil {
+			continue
+		}
+		switch *def.Defname {
+		case "subtype":
+			if stmt.Subtype, err = parseTypeName(def.Arg); err != nil {
+				panic(err)
+			}
+		case "multirange_type_name":
+			if stmt.MultirangeTypeName, err = parseTypeName(def.Arg); err != nil {
+				panic(err)
+			}
+		}
+	}
+	return stmt
 }
 
 func convertCreateRoleStmt(n *nodes.CreateRoleStmt) *ast.CreateRoleStmt {
@@ -253,7 +253,7 @@ func translate(node nodes.Node) (ast.Node, error) {
 	case nodes.AlterObjectSchemaStmt:
 		switch n.ObjectType {
 
-		case nodes.OBJECT_TABLE:
+		case nodes.OBJECT_FOREIGN_TABLE, nodes.OBJECT_TABLE:
 			tbl, err := parseTableName(*n.Relation)
 			if err != nil {
 				return nil, err
@@ -261,11 +261,27 @@ func translate(node nodes.Node) (ast.Node, error) {
 			return &ast.AlterTableSetSchemaStmt{
 				Table:     tbl,
 				NewSchema: n.Newschema,
+				MissingOk: n.MissingOk,
+			}, nil
+
+		case nodes.OBJECT_DOMAIN, nodes.OBJECT_TYPE:
+			name, err := parseTypeName(n.Object)
+			if err != nil {
+				return nil, err
+			}
+			return &ast.AlterTypeSetSchemaStmt{
+				Type:      name,
+				NewSchema: n.Newschema,
 			}, nil
+
 		}
+		// Views, materialized views and sequences aren't tracked by the catalog
 		return nil, errSkip
 
 	case nodes.AlterTableStmt:
+		if n.Relkind != nodes.OBJECT_TABLE && n.Relkind != nodes.OBJECT_FOREIGN_TABLE {
+			return nil, errSkip
+		}
 		name, err := parseTableName(*n.Relation)
 		if err != nil {
 			return nil, err
@@ -288,10 +304,11 @@ func translate(node nodes.Node) (ast.Node, error) {
 					}
 					item.Subtype = ast.AT_AddColumn
 					item.Def = &ast.ColumnDef{
-						Colname:   *d.Colname,
-						TypeName:  tn,
-						IsNotNull: isNotNull(d),
-						IsArray:   isArray(d.TypeName),
+						Colname:     *d.Colname,
+						TypeName:    tn,
+						IsNotNull:   isNotNull(d),
+						IsArray:     isArray(d.TypeName),
+						Constraints: convertList(d.Constraints),
 					}
 
 				case nodes.AT_AlterColumnType:
@@ -316,8 +333,28 @@ func translate(node nodes.Node) (ast.Node, error) {
 						IsArray:   isArray(d.TypeName),
 					}
 
+				case nodes.AT_AddConstraint:
+					con, ok := cmd.Def.(nodes.Constraint)
+					if !ok {
+						continue
+					}
+					item.Subtype = ast.AT_AddConstraint
+					item.Constraint = convertConstraint(&con)
+
+				case nodes.AT_ColumnDefault:
+					item.Subtype = ast.AT_ColumnDefault
+					item.Def = &ast.ColumnDef{
+						Colname:    *cmd.Name,
+						RawDefault: convertNode(cmd.Def),
+					}
+
 				case nodes.AT_DropColumn:
 					item.Subtype = ast.AT_DropColumn
+					item.Behavior = ast.DropBehavior(cmd.Behavior)
+
+				case nodes.AT_DropConstraint:
+					item.Subtype = ast.AT_DropConstraint
+					item.Behavior = ast.DropBehavior(cmd.Behavior)
 
 				case nodes.AT_DropNotNull:
 					item.Subtype = ast.AT_DropNotNull
@@ -386,35 +423,31 @@ func translate(node nodes.Node) (ast.Node, error) {
 		if err != nil {
 			return nil, err
 		}
-		return &ast.CompositeTypeStmt{
+		stmt := &ast.CompositeTypeStmt{
 			TypeName: name,
-		}, nil
-
-	case nodes.CreateStmt:
-		name, err := parseTableName(*n.Relation)
-		if err != nil {
-			return nil, err
 		}
-		create := &ast.CreateTableStmt{
-			Name:        name,
-			IfNotExists: n.IfNotExists,
-		}
-		for _, elt := range n.TableElts.Items {
-			switch n := elt.(type) {
-			case nodes.ColumnDef:
-				tn, err := parseTypeName(n.TypeName)
-				if err != nil {
-					return nil, err
-				}
-				create.Cols = append(create.Cols, &ast.ColumnDef{
-					Colname:   *n.Colname,
-					TypeName:  tn,
-					IsNotNull: isNotNull(n),
-					IsArray:   isArray(n.TypeName),
-				})
+		for _, item := range n.Coldeflist.Items {
+			d, ok := item.(nodes.ColumnDef)
+			if !ok {
+				continue
+			}
+			tn, err := parseTypeName(d.TypeName)
+			if err != nil {
+				return nil, err
 			}
+			stmt.Cols = append(stmt.Cols, &ast.ColumnDef{
+				Colname:  *d.Colname,
+				TypeName: tn,
+				IsArray:  isArray(d.TypeName),
+			})
 		}
-		return create, nil
+		return stmt, nil
+
+	case nodes.CreateForeignTableStmt:
+		return translateCreateStmt(n.Base)
+
+	case nodes.CreateStmt:
+		return translateCreateStmt(n)
 
 	case nodes.CreateEnumStmt:
 		name, err := parseTypeName(n.TypeName)
@@ -540,7 +573,7 @@ func translate(node nodes.Node) (ast.Node, error) {
 			}
 			return drop, nil
 
-		case nodes.OBJECT_TYPE:
+		case nodes.OBJECT_DOMAIN, nodes.OBJECT_TYPE:
 			drop := &ast.DropTypeStmt{
 				IfExists: n.MissingOk,
 			}
@@ -570,7 +603,7 @@ func translate(node nodes.Node) (ast.Node, error) {
 				NewName: n.Newname,
 			}, nil
 
-		case nodes.OBJECT_TABLE:
+		case nodes.OBJECT_FOREIGN_TABLE, nodes.OBJECT_TABLE:
 			tbl, err := parseTableName(*n.Relation)
 			if err != nil {
 				return nil, fmt.Errorf("nodes.RenameType: TABLE: %w", err)
@@ -580,6 +613,39 @@ func translate(node nodes.Node) (ast.Node, error) {
 				NewName: n.Newname,
 			}, nil
 
+		case nodes.OBJECT_INDEX:
+			idx, err := parseTableName(*n.Relation)
+			if err != nil {
+				return nil, fmt.Errorf("nodes.RenameType: INDEX: %w", err)
+			}
+			return &ast.RenameIndexStmt{
+				Index:     idx,
+				NewName:   n.Newname,
+				MissingOk: n.MissingOk,
+			}, nil
+
+		case nodes.OBJECT_TABCONSTRAINT:
+			tbl, err := parseTableName(*n.Relation)
+			if err != nil {
+				return nil, fmt.Errorf("nodes.RenameType: CONSTRAINT: %w", err)
+			}
+			return &ast.RenameConstraintStmt{
+				Table:      tbl,
+				Constraint: n.Subname,
+				NewName:    n.Newname,
+				MissingOk:  n.MissingOk,
+			}, nil
+
+		case nodes.OBJECT_DOMAIN, nodes.OBJECT_TYPE:
+			name, err := parseTypeName(n.Object)
+			if err != nil {
+				return nil, fmt.Errorf("nodes.RenameType: TYPE: %w", err)
+			}
+			return &ast.RenameTypeStmt{
+				Type:    name,
+				NewName: n.Newname,
+			}, nil
+
 		}
 		return nil, errSkip
 
@@ -587,3 +653,33 @@ func translate(node nodes.Node) (ast.Node, error) {
 		return convert(n)
 	}
 }
+
+func translateCreateStmt(n nodes.CreateStmt) (*ast.CreateTableStmt, error) {
+	name, err := parseTableName(*n.Relation)
+	if err != nil {
+		return nil, err
+	}
+	create := &ast.CreateTableStmt{
+		Name:        name,
+		IfNotExists: n.IfNotExists,
+	}
+	for _, elt := range n.TableElts.Items {
+		switch n := elt.(type) {
+		case nodes.ColumnDef:
+			tn, err := parseTypeName(n.TypeName)
+			if err != nil {
+				return nil, err
+			}
+			create.Cols = append(create.Cols, &ast.ColumnDef{
+				Colname:     *n.Colname,
+				TypeName:    tn,
+				IsNotNull:   isNotNull(n),
+				IsArray:     isArray(n.TypeName),
+				Constraints: convertList(n.Constraints),
+			})
+		case nodes.Constraint:
+			create.Constraints = append(create.Constraints, convertConstraint(&n))
+		}
+	}
+	return create, nil
+}
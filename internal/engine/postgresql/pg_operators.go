@@ -0,0 +1,202 @@
+package postgresql
+
+import (
+	"github.com/kyleconroy/sqlc/internal/sql/ast"
+	"github.com/kyleconroy/sqlc/internal/sql/catalog"
+)
+
+// The operators of pg_catalog used to infer the types of expressions. Unlike
+// functions, these aren't generated from a running database: the list covers
+// the built-in types sqlc maps to Go types.
+//
+// https://www.postgresql.org/docs/current/functions.html
+func pgOperators() []*catalog.Operator {
+	var ops []*catalog.Operator
+	binary := func(name, left, right, ret string) {
+		ops = append(ops, &catalog.Operator{
+			Name:       name,
+			Left:       &ast.TypeName{Name: left},
+			Right:      &ast.TypeName{Name: right},
+			ReturnType: &ast.TypeName{Name: ret},
+		})
+	}
+	prefix := func(name, right, ret string) {
+		ops = append(ops, &catalog.Operator{
+			Name:       name,
+			Right:      &ast.TypeName{Name: right},
+			ReturnType: &ast.TypeName{Name: ret},
+		})
+	}
+	comparison := func(typ string) {
+		for _, name := range []string{"=", "<>", "<", "<=", ">", ">="} {
+			binary(name, typ, typ, "boolean")
+		}
+	}
+
+	// Mathematical operators
+	//
+	// https://www.postgresql.org/docs/current/functions-math.html
+	for _, typ := range []string{"smallint", "integer", "bigint", "numeric", "real", "double precision"} {
+		comparison(typ)
+		for _, name := range []string{"+", "-", "*", "/"} {
+			binary(name, typ, typ, typ)
+		}
+		prefix("+", typ, typ)
+		prefix("-", typ, typ)
+		prefix("@", typ, typ)
+	}
+	for _, typ := range []string{"smallint", "integer", "bigint", "numeric"} {
+		binary("%", typ, typ, typ)
+	}
+	for _, typ := range []string{"smallint", "integer", "bigint"} {
+		for _, name := range []string{"&", "|", "#"} {
+			binary(name, typ, typ, typ)
+		}
+		binary("<<", typ, "integer", typ)
+		binary(">>", typ, "integer", typ)
+		prefix("~", typ, typ)
+	}
+	binary("^", "double precision", "double precision", "double precision")
+	binary("^", "numeric", "numeric", "numeric")
+	prefix("|/", "double precision", "double precision")
+	prefix("||/", "double precision", "double precision")
+	comparison("money")
+	binary("+", "money", "money", "money")
+	binary("-", "money", "money", "money")
+	binary("*", "money", "double precision", "money")
+	binary("/", "money", "double precision", "money")
+	binary("/", "money", "money", "double precision")
+
+	// String operators
+	//
+	// https://www.postgresql.org/docs/current/functions-string.html
+	// https://www.postgresql.org/docs/current/functions-matching.html
+	for _, typ := range []string{"text", "character", "name"} {
+		comparison(typ)
+		for _, name := range []string{"~~", "!~~", "~~*", "!~~*", "~", "!~", "~*", "!~*"} {
+			binary(name, typ, "text", "boolean")
+		}
+	}
+	binary("||", "text", "text", "text")
+	binary("||", "text", "anynonarray", "text")
+	binary("||", "anynonarray", "text", "text")
+	binary("^@", "text", "text", "boolean")
+
+	comparison("boolean")
+	comparison("bytea")
+	binary("||", "bytea", "bytea", "bytea")
+	comparison("uuid")
+	comparison("oid")
+	comparison("anyenum")
+
+	// Date/time operators
+	//
+	// https://www.postgresql.org/docs/current/functions-datetime.html
+	for _, typ := range []string{"date", "time without time zone", "time with time zone", "timestamp without time zone", "timestamp with time zone", "interval"} {
+		comparison(typ)
+	}
+	binary("+", "date", "integer", "date")
+	binary("+", "integer", "date", "date")
+	binary("-", "date", "integer", "date")
+	binary("-", "date", "date", "integer")
+	binary("+", "date", "interval", "timestamp without time zone")
+	binary("-", "date", "interval", "timestamp without time zone")
+	binary("+", "date", "time without time zone", "timestamp without time zone")
+	binary("+", "time without time zone", "interval", "time without time zone")
+	binary("-", "time without time zone", "interval", "time without time zone")
+	binary("-", "time without time zone", "time without time zone", "interval")
+	binary("+", "time with time zone", "interval", "time with time zone")
+	binary("-", "time with time zone", "interval", "time with time zone")
+	for _, typ := range []string{"timestamp without time zone", "timestamp with time zone"} {
+		binary("+", typ, "interval", typ)
+		binary("+", "interval", typ, typ)
+		binary("-", typ, "interval", typ)
+		binary("-", typ, typ, "interval")
+	}
+	binary("+", "interval", "interval", "interval")
+	binary("-", "interval", "interval", "interval")
+	binary("*", "interval", "double precision", "interval")
+	binary("*", "double precision", "interval", "interval")
+	binary("/", "interval", "double precision", "interval")
+	prefix("-", "interval", "interval")
+
+	// JSON operators
+	//
+	// https://www.postgresql.org/docs/current/functions-json.html
+	for _, typ := range []string{"json", "jsonb"} {
+		binary("->", typ, "text", typ)
+		binary("->", typ, "integer", typ)
+		binary("->>", typ, "text", "text")
+		binary("->>", typ, "integer", "text")
+		binary("#>", typ, "text[]", typ)
+		binary("#>>", typ, "text[]", "text")
+	}
+	comparison("jsonb")
+	binary("@>", "jsonb", "jsonb", "boolean")
+	binary("<@", "jsonb", "jsonb", "boolean")
+	binary("?", "jsonb", "text", "boolean")
+	binary("?|", "jsonb", "text[]", "boolean")
+	binary("?&", "jsonb", "text[]", "boolean")
+	binary("||", "jsonb", "jsonb", "jsonb")
+	binary("-", "jsonb", "text", "jsonb")
+	binary("-", "jsonb", "text[]", "jsonb")
+	binary("-", "jsonb", "integer", "jsonb")
+	binary("#-", "jsonb", "text[]", "jsonb")
+
+	// Network address operators
+	//
+	// https://www.postgresql.org/docs/current/functions-net.html
+	comparison("inet")
+	for _, name := range []string{"<<", "<<=", ">>", ">>=", "&&"} {
+		binary(name, "inet", "inet", "boolean")
+	}
+	prefix("~", "inet", "inet")
+	binary("&", "inet", "inet", "inet")
+	binary("|", "inet", "inet", "inet")
+	binary("+", "inet", "bigint", "inet")
+	binary("-", "inet", "bigint", "inet")
+	binary("-", "inet", "inet", "bigint")
+
+	// Array operators
+	//
+	// https://www.postgresql.org/docs/current/functions-array.html
+	comparison("anyarray")
+	binary("@>", "anyarray", "anyarray", "boolean")
+	binary("<@", "anyarray", "anyarray", "boolean")
+	binary("&&", "anyarray", "anyarray", "boolean")
+	binary("||", "anyarray", "anyarray", "anyarray")
+	binary("||", "anyelement", "anyarray", "anyarray")
+	binary("||", "anyarray", "anyelement", "anyarray")
+
+	// Range and multirange operators
+	//
+	// https://www.postgresql.org/docs/current/functions-range.html
+	for _, typ := range []string{"anyrange", "anymultirange"} {
+		comparison(typ)
+		for _, name := range []string{"@>", "<@"} {
+			binary(name, typ, "anyelement", "boolean")
+			binary(name, "anyelement", typ, "boolean")
+		}
+		for _, other := range []string{"anyrange", "anymultirange"} {
+			for _, name := range []string{"@>", "<@", "&&", "<<", ">>", "&<", "&>", "-|-"} {
+				binary(name, typ, other, "boolean")
+			}
+		}
+		for _, name := range []string{"+", "*", "-"} {
+			binary(name, typ, typ, typ)
+		}
+	}
+
+	// Text search operators
+	//
+	// https://www.postgresql.org/docs/current/functions-textsearch.html
+	binary("@@", "tsvector", "tsquery", "boolean")
+	binary("@@", "tsquery", "tsvector", "boolean")
+	binary("@@", "text", "tsquery", "boolean")
+	binary("||", "tsvector", "tsvector", "tsvector")
+	binary("&&", "tsquery", "tsquery", "tsquery")
+	binary("||", "tsquery", "tsquery", "tsquery")
+	prefix("!!", "tsquery", "tsquery")
+
+	return ops
+}
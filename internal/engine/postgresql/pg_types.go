@@ -0,0 +1,29 @@
+package postgresql
+
+import (
+	"github.com/kyleconroy/sqlc/internal/sql/ast"
+	"github.com/kyleconroy/sqlc/internal/sql/catalog"
+)
+
+// The range types of pg_catalog and their multirange types.
+//
+// https://www.postgresql.org/docs/current/rangetypes.html#RANGETYPES-BUILTIN
+func pgTypes() []catalog.Type {
+	var types []catalog.Type
+	for _, r := range []struct {
+		name, subtype, multirange string
+	}{
+		{"int4range", "integer", "int4multirange"},
+		{"int8range", "bigint", "int8multirange"},
+		{"numrange", "numeric", "nummultirange"},
+		{"tsrange", "timestamp", "tsmultirange"},
+		{"tstzrange", "timestamptz", "tstzmultirange"},
+		{"daterange", "date", "datemultirange"},
+	} {
+		types = append(types,
+			&catalog.Range{Name: r.name, Subtype: ast.TypeName{Name: r.subtype}},
+			&catalog.Multirange{Name: r.multirange, Range: r.name},
+		)
+	}
+	return types
+}
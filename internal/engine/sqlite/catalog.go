@@ -4,5 +4,6 @@ import "github.com/kyleconroy/sqlc/internal/sql/catalog"
 
 func NewCatalog() *catalog.Catalog {
 	c := catalog.New("main")
+	c.LooseTypes = true
 	return c
 }
@@ -1,10 +1,15 @@
 package sqlite
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
 	"github.com/antlr/antlr4/runtime/Go/antlr"
 
 	"github.com/kyleconroy/sqlc/internal/engine/sqlite/parser"
 	"github.com/kyleconroy/sqlc/internal/sql/ast"
+	"github.com/kyleconroy/sqlc/internal/sql/sqlerr"
 )
 
 type node interface {
@@ -114,7 +119,9 @@ func convertFactored_select_stmtContext(c *parser.Factored_select_stmtContext) a
 					Location: col.GetStart().GetStart(),
 				}
 			case iexpr != nil:
-				val = convert(iexpr)
+				if expr, ok := iexpr.(*parser.ExprContext); ok {
+					val = convertExprContext(expr)
+				}
 			}
 			if val == nil {
 				continue
@@ -147,7 +154,124 @@ func convertFactored_select_stmtContext(c *parser.Factored_select_stmtContext) a
 	}
 }
 
-func convertSql_stmtContext(n *parser.Sql_stmtContext) ast.Node {
+// convertInsert_stmtContext converts an INSERT statement. Only the VALUES
+// form is supported, with parameters and literals as values.
+func convertInsert_stmtContext(c *parser.Insert_stmtContext) (ast.Node, error) {
+	tableName := c.Table_name().GetText()
+	rel := &ast.RangeVar{
+		Relname:  &tableName,
+		Location: c.Table_name().GetStart().GetStart(),
+	}
+	if c.Database_name() != nil {
+		schemaName := c.Database_name().GetText()
+		rel.Schemaname = &schemaName
+	}
+	insert := &ast.InsertStmt{
+		Relation:      rel,
+		Cols:          &ast.List{},
+		ReturningList: &ast.List{},
+	}
+	for _, icol := range c.AllColumn_name() {
+		name := icol.GetText()
+		insert.Cols.Items = append(insert.Cols.Items, &ast.ResTarget{
+			Name:     &name,
+			Location: icol.GetStart().GetStart(),
+		})
+	}
+	if c.K_VALUES() == nil || c.K_DEFAULT() != nil {
+		return &ast.TODO{}, nil
+	}
+
+	// Each parenthesized list of expressions after VALUES is a row
+	values := &ast.List{}
+	var row *ast.List
+	var params int
+	var inValues bool
+	for _, child := range c.GetChildren() {
+		switch n := child.(type) {
+		case antlr.TerminalNode:
+			switch n.GetSymbol().GetTokenType() {
+			case parser.SQLiteParserK_VALUES:
+				inValues = true
+			case parser.SQLiteParserOPEN_PAR:
+				if inValues {
+					row = &ast.List{}
+				}
+			case parser.SQLiteParserCLOSE_PAR:
+				if inValues && row != nil {
+					values.Items = append(values.Items, row)
+					row = nil
+				}
+			}
+		case *parser.ExprContext:
+			if row == nil {
+				continue
+			}
+			val, err := convertValue(n, &params)
+			if err != nil {
+				return nil, err
+			}
+			row.Items = append(row.Items, val)
+		}
+	}
+	insert.SelectStmt = &ast.SelectStmt{
+		FromClause:  &ast.List{},
+		TargetList:  &ast.List{},
+		ValuesLists: values,
+	}
+	return insert, nil
+}
+
+// convertValue converts a parameter or a literal. Parameters are numbered
+// explicitly, as in "?2", or like SQLite does, one more than the largest
+// number so far, which params holds. Any other expression is an error, as
+// the parameters it may hold would be lost.
+func convertValue(c *parser.ExprContext, params *int) (ast.Node, error) {
+	if bind := c.BIND_PARAMETER(); bind != nil {
+		number := *params + 1
+		if text := bind.GetText(); strings.HasPrefix(text, "?") && len(text) > 1 {
+			if n, err := strconv.Atoi(text[1:]); err == nil {
+				number = n
+			}
+		}
+		if number > *params {
+			*params = number
+		}
+		return &ast.ParamRef{
+			Number:   number,
+			Location: bind.GetSymbol().GetStart(),
+		}, nil
+	}
+	lit, ok := c.Literal_value().(*parser.Literal_valueContext)
+	if !ok {
+		return nil, unsupportedExpr(c)
+	}
+	location := lit.GetStart().GetStart()
+	switch {
+	case lit.NUMERIC_LITERAL() != nil:
+		text := lit.GetText()
+		if i, err := strconv.ParseInt(text, 10, 64); err == nil {
+			return &ast.A_Const{Val: &ast.Integer{Ival: i}, Location: location}, nil
+		}
+		return &ast.A_Const{Val: &ast.Float{Str: text}, Location: location}, nil
+	case lit.STRING_LITERAL() != nil:
+		text := lit.GetText()
+		text = strings.ReplaceAll(text[1:len(text)-1], "''", "'")
+		return &ast.A_Const{Val: &ast.String{Str: text}, Location: location}, nil
+	case lit.K_NULL() != nil:
+		return &ast.A_Const{Val: &ast.Null{}, Location: location}, nil
+	}
+	return nil, unsupportedExpr(c)
+}
+
+func unsupportedExpr(c *parser.ExprContext) error {
+	return &sqlerr.Error{
+		Message:  fmt.Sprintf("unsupported expression: %s", c.GetText()),
+		Location: c.GetStart().GetStart(),
+	}
+}
+
+func convertSql_stmtContext(n *parser.Sql_stmtContext) (ast.Node, error) {
 	if stmt := n.Alter_table_stmt(); stmt != nil {
 		return convert(stmt)
 	}
@@ -238,34 +362,37 @@ func convertSql_stmtContext(n *parser.Sql_stmtContext) ast.Node {
 	if stmt := n.Vacuum_stmt(); stmt != nil {
 		return convert(stmt)
 	}
-	return nil
+	return nil, nil
 }
 
-func convert(node node) ast.Node {
+func convert(node node) (ast.Node, error) {
 	switch n := node.(type) {
 
 	case *parser.Alter_table_stmtContext:
-		return convertAlter_table_stmtContext(n)
+		return convertAlter_table_stmtContext(n), nil
 
 	case *parser.Attach_stmtContext:
-		return convertAttach_stmtContext(n)
+		return convertAttach_stmtContext(n), nil
 
 	case *parser.Create_table_stmtContext:
-		return convertCreate_table_stmtContext(n)
+		return convertCreate_table_stmtContext(n), nil
 
 	case *parser.Drop_table_stmtContext:
-		return convertDrop_table_stmtContext(n)
+		return convertDrop_table_stmtContext(n), nil
 
 	case *parser.ExprContext:
-		return convertExprContext(n)
+		return convertExprContext(n), nil
 
 	case *parser.Factored_select_stmtContext:
-		return convertFactored_select_stmtContext(n)
+		return convertFactored_select_stmtContext(n), nil
+
+	case *parser.Insert_stmtContext:
+		return convertInsert_stmtContext(n)
 
 	case *parser.Sql_stmtContext:
 		return convertSql_stmtContext(n)
 
 	default:
-		return &ast.TODO{}
+		return &ast.TODO{}, nil
 	}
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"strings"
 
 	"github.com/antlr/antlr4/runtime/Go/antlr"
 
@@ -67,7 +68,10 @@ func (p *Parser) Parse(r io.Reader) ([]ast.Statement, error) {
 		}
 		loc := 0
 		for _, stmt := range list.AllSql_stmt() {
-			out := convert(stmt)
+			out, err := convert(stmt)
+			if err != nil {
+				return nil, err
+			}
 			if _, ok := out.(*ast.TODO); ok {
 				continue
 			}
@@ -78,7 +82,11 @@ func (p *Parser) Parse(r io.Reader) ([]ast.Statement, error) {
 					StmtLen:      stmt.GetStop().GetStop() - loc + 1,
 				},
 			})
-			loc = stmt.GetStop().GetStop() - loc
+			// The next statement starts after the semicolon ending this one
+			loc = stmt.GetStop().GetStop() + 1
+			if semi := strings.IndexByte(string(blob[loc:]), ';'); semi >= 0 {
+				loc += semi + 1
+			}
 		}
 	}
 	return stmts, nil
@@ -14,6 +14,7 @@ type CommentSyntax struct {
 
 const (
 	CmdExec       = ":exec"
+	CmdExecLastId = ":execlastid"
 	CmdExecResult = ":execresult"
 	CmdExecRows   = ":execrows"
 	CmdMany       = ":many"
@@ -72,7 +73,7 @@ func Parse(t string, commentStyle CommentSyntax) (string, string, error) {
 			part = part[:len(part)-1] // removes the trailing "*/" element
 		}
 		if len(part) == 2 {
-			return "", "", fmt.Errorf("missing query type [':one', ':many', ':exec', ':execrows', ':execresult']: %s", line)
+			return "", "", fmt.Errorf("missing query type [':one', ':many', ':exec', ':execrows', ':execlastid', ':execresult']: %s", line)
 		}
 		if len(part) != 4 {
 			return "", "", fmt.Errorf("invalid query comment: %s", line)
@@ -80,7 +81,7 @@ func Parse(t string, commentStyle CommentSyntax) (string, string, error) {
 		queryName := part[2]
 		queryType := strings.TrimSpace(part[3])
 		switch queryType {
-		case CmdOne, CmdMany, CmdExec, CmdExecResult, CmdExecRows:
+		case CmdOne, CmdMany, CmdExec, CmdExecResult, CmdExecRows, CmdExecLastId:
 		default:
 			return "", "", fmt.Errorf("invalid query type: %s", queryType)
 		}
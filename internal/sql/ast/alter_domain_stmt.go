@@ -4,7 +4,7 @@ import ()
 
 type AlterDomainStmt struct {
 	Subtype   byte
-	TypeName  *List
+	TypeName  *TypeName
 	Name      *string
 	Def       Node
 	Behavior  DropBehavior
@@ -6,6 +6,11 @@ const (
 	AT_DropColumn
 	AT_DropNotNull
 	AT_SetNotNull
+	AT_ColumnDefault
+	AT_AddConstraint
+	AT_DropConstraint
+	AT_ChangeColumn
+	AT_RenameColumn
 )
 
 type AlterTableType int
@@ -22,18 +27,30 @@ func (t AlterTableType) String() string {
 		return "DropNotNull"
 	case AT_SetNotNull:
 		return "SetNotNull"
+	case AT_ColumnDefault:
+		return "ColumnDefault"
+	case AT_AddConstraint:
+		return "AddConstraint"
+	case AT_DropConstraint:
+		return "DropConstraint"
+	case AT_ChangeColumn:
+		return "ChangeColumn"
+	case AT_RenameColumn:
+		return "RenameColumn"
 	default:
 		return "Unknown"
 	}
 }
 
 type AlterTableCmd struct {
-	Subtype   AlterTableType
-	Name      *string
-	Def       *ColumnDef
-	Newowner  *RoleSpec
-	Behavior  DropBehavior
-	MissingOk bool
+	Subtype    AlterTableType
+	Name       *string
+	NewName    *string
+	Def        *ColumnDef
+	Constraint *Constraint
+	Newowner   *RoleSpec
+	Behavior   DropBehavior
+	MissingOk  bool
 }
 
 func (n *AlterTableCmd) Pos() int {
@@ -3,6 +3,7 @@ package ast
 type AlterTableSetSchemaStmt struct {
 	Table     *TableName
 	NewSchema *string
+	MissingOk bool
 }
 
 func (n *AlterTableSetSchemaStmt) Pos() int {
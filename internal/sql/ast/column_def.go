@@ -6,6 +6,9 @@ type ColumnDef struct {
 	IsNotNull bool
 	IsArray   bool
 	Vals      *List
+	Unsigned  bool
+	Length    *int
+	Generated bool
 
 	// From pg.ColumnDef
 	Inhcount      int
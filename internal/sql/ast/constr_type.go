@@ -2,6 +2,22 @@ package ast
 
 type ConstrType uint
 
+const (
+	CONSTR_NULL ConstrType = iota
+	CONSTR_NOTNULL
+	CONSTR_DEFAULT
+	CONSTR_IDENTITY
+	CONSTR_CHECK
+	CONSTR_PRIMARY
+	CONSTR_UNIQUE
+	CONSTR_EXCLUSION
+	CONSTR_FOREIGN
+	CONSTR_ATTR_DEFERRABLE
+	CONSTR_ATTR_NOT_DEFERRABLE
+	CONSTR_ATTR_DEFERRED
+	CONSTR_ATTR_IMMEDIATE
+)
+
 func (n *ConstrType) Pos() int {
 	return 0
 }
@@ -3,8 +3,9 @@ package ast
 import ()
 
 type CreateDomainStmt struct {
-	Domainname  *List
+	Domainname  *TypeName
 	TypeName    *TypeName
+	IsArray     bool
 	CollClause  *CollateClause
 	Constraints *List
 }
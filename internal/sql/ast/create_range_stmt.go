@@ -3,8 +3,10 @@ package ast
 import ()
 
 type CreateRangeStmt struct {
-	TypeName *List
-	Params   *List
+	TypeName           *TypeName
+	Subtype            *TypeName
+	MultirangeTypeName *TypeName
+	Params             *List
 }
 
 func (n *CreateRangeStmt) Pos() int {
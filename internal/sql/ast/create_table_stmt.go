@@ -4,6 +4,7 @@ type CreateTableStmt struct {
 	IfNotExists bool
 	Name        *TableName
 	Cols        []*ColumnDef
+	Constraints []*Constraint
 	ReferTable  *TableName
 	Comment     string
 }
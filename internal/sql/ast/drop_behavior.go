@@ -2,6 +2,11 @@ package ast
 
 type DropBehavior uint
 
+const (
+	DROP_RESTRICT DropBehavior = iota
+	DROP_CASCADE
+)
+
 func (n *DropBehavior) Pos() int {
 	return 0
 }
@@ -2,6 +2,13 @@ package ast
 
 type JoinType uint
 
+const (
+	JOIN_INNER JoinType = iota
+	JOIN_LEFT
+	JOIN_FULL
+	JOIN_RIGHT
+)
+
 func (n *JoinType) Pos() int {
 	return 0
 }
@@ -2,6 +2,12 @@ package ast
 
 type OnConflictAction uint
 
+const (
+	ONCONFLICT_NONE OnConflictAction = iota
+	ONCONFLICT_NOTHING
+	ONCONFLICT_UPDATE
+)
+
 func (n *OnConflictAction) Pos() int {
 	return 0
 }
@@ -0,0 +1,12 @@
+package ast
+
+type RenameConstraintStmt struct {
+	Table      *TableName
+	Constraint *string
+	NewName    *string
+	MissingOk  bool
+}
+
+func (n *RenameConstraintStmt) Pos() int {
+	return 0
+}
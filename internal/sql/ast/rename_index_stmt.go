@@ -0,0 +1,11 @@
+package ast
+
+type RenameIndexStmt struct {
+	Index     *TableName
+	NewName   *string
+	MissingOk bool
+}
+
+func (n *RenameIndexStmt) Pos() int {
+	return 0
+}
@@ -2,6 +2,26 @@ package ast
 
 type SetOperation uint
 
+const (
+	None SetOperation = iota
+	Union
+	Intersect
+	Except
+)
+
+func (n SetOperation) String() string {
+	switch n {
+	case Union:
+		return "UNION"
+	case Intersect:
+		return "INTERSECT"
+	case Except:
+		return "EXCEPT"
+	default:
+		return ""
+	}
+}
+
 func (n *SetOperation) Pos() int {
 	return 0
 }
@@ -0,0 +1,11 @@
+package ast
+
+// UseStmt makes Schema the default for unqualified names, as MySQL's USE
+// statement does for a database.
+type UseStmt struct {
+	Schema string
+}
+
+func (n *UseStmt) Pos() int {
+	return 0
+}
@@ -152,6 +152,9 @@ func (a *application) apply(parent ast.Node, name string, iter *iterator, n ast.
 	case *ast.AlterTypeRenameValueStmt:
 		a.apply(n, "Type", nil, n.Type)
 
+	case *ast.AlterTypeSetSchemaStmt:
+		a.apply(n, "Type", nil, n.Type)
+
 	case *ast.CommentOnColumnStmt:
 		a.apply(n, "Table", nil, n.Table)
 		a.apply(n, "Col", nil, n.Col)
@@ -201,9 +204,18 @@ func (a *application) apply(parent ast.Node, name string, iter *iterator, n ast.
 		a.apply(n, "Table", nil, n.Table)
 		a.apply(n, "Col", nil, n.Col)
 
+	case *ast.RenameConstraintStmt:
+		a.apply(n, "Table", nil, n.Table)
+
+	case *ast.RenameIndexStmt:
+		a.apply(n, "Index", nil, n.Index)
+
 	case *ast.RenameTableStmt:
 		a.apply(n, "Table", nil, n.Table)
 
+	case *ast.RenameTypeStmt:
+		a.apply(n, "Type", nil, n.Type)
+
 	case *ast.Statement:
 		a.apply(n, "Raw", nil, n.Raw)
 
@@ -356,6 +368,7 @@ func (a *application) apply(parent ast.Node, name string, iter *iterator, n ast.
 	case *ast.AlterTableCmd:
 		a.apply(n, "Newowner", nil, n.Newowner)
 		a.apply(n, "Def", nil, n.Def)
+		a.apply(n, "Constraint", nil, n.Constraint)
 
 	case *ast.AlterTableMoveAllStmt:
 		a.apply(n, "Roles", nil, n.Roles)
@@ -1152,6 +1165,9 @@ func (a *application) apply(parent ast.Node, name string, iter *iterator, n ast.
 	case *ast.VariableSetStmt:
 		a.apply(n, "Args", nil, n.Args)
 
+	case *ast.UseStmt:
+		// pass
+
 	case *ast.VariableShowStmt:
 		// pass
 
@@ -49,6 +49,11 @@ func Walk(f Visitor, node ast.Node) {
 			Walk(f, n.Type)
 		}
 
+	case *ast.AlterTypeSetSchemaStmt:
+		if n.Type != nil {
+			Walk(f, n.Type)
+		}
+
 	case *ast.CommentOnColumnStmt:
 		if n.Table != nil {
 			Walk(f, n.Table)
@@ -81,6 +86,12 @@ func Walk(f Visitor, node ast.Node) {
 		if n.Name != nil {
 			Walk(f, n.Name)
 		}
+		for _, col := range n.Cols {
+			Walk(f, col)
+		}
+		for _, con := range n.Constraints {
+			Walk(f, con)
+		}
 
 	case *ast.DropFunctionStmt:
 		// pass
@@ -123,11 +134,26 @@ func Walk(f Visitor, node ast.Node) {
 			Walk(f, n.Col)
 		}
 
+	case *ast.RenameConstraintStmt:
+		if n.Table != nil {
+			Walk(f, n.Table)
+		}
+
+	case *ast.RenameIndexStmt:
+		if n.Index != nil {
+			Walk(f, n.Index)
+		}
+
 	case *ast.RenameTableStmt:
 		if n.Table != nil {
 			Walk(f, n.Table)
 		}
 
+	case *ast.RenameTypeStmt:
+		if n.Type != nil {
+			Walk(f, n.Type)
+		}
+
 	case *ast.Statement:
 		if n.Raw != nil {
 			Walk(f, n.Raw)
@@ -413,6 +439,9 @@ func Walk(f Visitor, node ast.Node) {
 		if n.Def != nil {
 			Walk(f, n.Def)
 		}
+		if n.Constraint != nil {
+			Walk(f, n.Constraint)
+		}
 
 	case *ast.AlterTableMoveAllStmt:
 		if n.Roles != nil {
@@ -2029,6 +2058,9 @@ func Walk(f Visitor, node ast.Node) {
 			Walk(f, n.Args)
 		}
 
+	case *ast.UseStmt:
+		// pass
+
 	case *ast.VariableShowStmt:
 		// pass
 
@@ -25,6 +25,21 @@ type Catalog struct {
 	SearchPath    []string
 	LoadExtension func(string) *Schema
 
+	// Values of any type are implicitly converted where another type is
+	// expected, as in MySQL and SQLite. Argument types then only rank
+	// function overloads instead of ruling them out.
+	LooseTypes bool
+
+	// Functions can return NULL for arguments that aren't, as many of MySQL's
+	// do for invalid input, unless they're declared strict. Otherwise results
+	// are assumed not to be NULL.
+	NullableFuncs bool
+
+	// Unnamed unique indexes are named after their first column, with a
+	// number added if another index has the name, as in MySQL. Otherwise
+	// they're named the way PostgreSQL names constraints.
+	ColumnIndexNames bool
+
 	// TODO: un-export
 	Extensions map[string]struct{}
 }
@@ -90,6 +105,8 @@ type Schema struct {
 	Types  []Type
 	Funcs  []*Function
 
+	Operators []*Operator
+
 	Comment string
 }
 
@@ -175,15 +192,41 @@ func (s *Schema) getType(rel *ast.TypeName) (Type, int, error) {
 			if typ.Name == rel.Name {
 				return s.Types[i], i, nil
 			}
+		case *CompositeType:
+			if typ.Name == rel.Name {
+				return s.Types[i], i, nil
+			}
+		case *Domain:
+			if typ.Name == rel.Name {
+				return s.Types[i], i, nil
+			}
+		case *Multirange:
+			if typ.Name == rel.Name {
+				return s.Types[i], i, nil
+			}
+		case *Set:
+			if typ.Name == rel.Name {
+				return s.Types[i], i, nil
+			}
+		case *Range:
+			if typ.Name == rel.Name {
+				return s.Types[i], i, nil
+			}
 		}
 	}
 	return nil, -1, sqlerr.TypeNotFound(rel.Name)
 }
 
 type Table struct {
-	Rel     *ast.TableName
-	Columns []*Column
-	Comment string
+	Rel         *ast.TableName
+	Columns     []*Column
+	Constraints []*Constraint
+	Comment     string
+
+	// Set when the table may have constraints that aren't listed in
+	// Constraints, or that are listed under a name the database didn't give
+	// them, such as the names PostgreSQL generates
+	UnknownConstraints bool
 }
 
 // TODO: Should this just be ast Nodes?
@@ -193,6 +236,29 @@ type Column struct {
 	IsNotNull bool
 	IsArray   bool
 	Comment   string
+
+	// The UNSIGNED attribute and the length, display width or precision of
+	// MySQL columns, such as the 1 of TINYINT(1)
+	Unsigned bool
+	Length   *int
+
+	// Generated columns are computed from other columns. They can be read,
+	// but values can't be inserted into them.
+	Generated bool
+}
+
+// A Constraint is a primary key, unique, check or foreign key constraint on
+// the columns of a table. Unique indexes are recorded as unique constraints,
+// as either can be the arbiter of an ON CONFLICT clause.
+type Constraint struct {
+	Name    string
+	Type    ast.ConstrType
+	Columns []string
+
+	// The table and columns referenced by a foreign key. If no columns are
+	// listed, the primary key of the table is referenced.
+	RefTable   *ast.TableName
+	RefColumns []string
 }
 
 type Type interface {
@@ -205,6 +271,10 @@ type Enum struct {
 	Name    string
 	Vals    []string
 	Comment string
+
+	// column is set for the enums of MySQL ENUM columns, which are dropped
+	// with their column
+	column bool
 }
 
 func (e *Enum) SetComment(c string) {
@@ -214,8 +284,28 @@ func (e *Enum) SetComment(c string) {
 func (e *Enum) isType() {
 }
 
+// A Set is a MySQL SET type. A value of a set holds any number of the values
+// of the set, separated by commas.
+//
+// https://dev.mysql.com/doc/refman/8.0/en/set.html
+type Set struct {
+	Name    string
+	Vals    []string
+	Comment string
+}
+
+func (s *Set) SetComment(c string) {
+	s.Comment = c
+}
+
+func (s *Set) isType() {
+}
+
+// A CompositeType is the structure of a row, given by its list of columns.
+// The columns of a composite type can always be null.
 type CompositeType struct {
 	Name    string
+	Columns []*Column
 	Comment string
 }
 
@@ -226,12 +316,70 @@ func (ct *CompositeType) SetComment(c string) {
 	ct.Comment = c
 }
 
+// A Domain is a data type with optional constraints, such as NOT NULL and
+// CHECK, based on another type. Values of a domain are stored as values of
+// its base type.
+type Domain struct {
+	Name        string
+	BaseType    ast.TypeName
+	IsArray     bool
+	NotNull     bool
+	Constraints []*Constraint
+	Comment     string
+}
+
+func (d *Domain) isType() {
+}
+
+func (d *Domain) SetComment(c string) {
+	d.Comment = c
+}
+
+// A Range is a range of values of its subtype, such as tstzrange.
+//
+// https://www.postgresql.org/docs/current/rangetypes.html
+type Range struct {
+	Name    string
+	Subtype ast.TypeName
+	Comment string
+}
+
+func (r *Range) isType() {
+}
+
+func (r *Range) SetComment(c string) {
+	r.Comment = c
+}
+
+// A Multirange is an ordered list of non-overlapping ranges. Every range
+// type has a corresponding multirange type.
+type Multirange struct {
+	Name    string
+	Range   string
+	Comment string
+}
+
+func (m *Multirange) isType() {
+}
+
+func (m *Multirange) SetComment(c string) {
+	m.Comment = c
+}
+
 type Function struct {
 	Name       string
 	Args       []*Argument
 	ReturnType *ast.TypeName
 	Comment    string
 	Desc       string
+
+	// The function can return NULL even when none of its arguments are
+	// NULL, as aggregate functions do when there are no rows
+	ReturnTypeNullable bool
+
+	// The function returns NULL only when one of its arguments is NULL, in
+	// a catalog whose functions can otherwise return NULL
+	Strict bool
 }
 
 func (f *Function) InArgs() []*Argument {
@@ -247,6 +395,37 @@ func (f *Function) InArgs() []*Argument {
 	return args
 }
 
+// CallArg returns the argument that receives the value at position i of a
+// call. Values past the declared arguments are collected by a trailing
+// variadic argument, whose element type is returned. It returns nil if the
+// function doesn't take that many arguments.
+func (f *Function) CallArg(i int) *Argument {
+	args := f.InArgs()
+	if i < len(args) && args[i].Mode != ast.FuncParamVariadic {
+		return args[i]
+	}
+	if len(args) == 0 || i < len(args)-1 {
+		return nil
+	}
+	last := args[len(args)-1]
+	if last.Mode != ast.FuncParamVariadic {
+		return nil
+	}
+	return &Argument{
+		Name: last.Name,
+		Type: variadicElem(last.Type),
+		Mode: last.Mode,
+	}
+}
+
+// An Operator is a binary operator, or a prefix operator if Left is nil.
+type Operator struct {
+	Name       string
+	Left       *ast.TypeName
+	Right      *ast.TypeName
+	ReturnType *ast.TypeName
+}
+
 type Argument struct {
 	Name       string
 	Type       *ast.TypeName
@@ -279,6 +458,9 @@ func (c *Catalog) Update(stmt ast.Statement) error {
 	}
 	var err error
 	switch n := stmt.Raw.Stmt.(type) {
+	case *ast.AlterDomainStmt:
+		err = c.alterDomain(n)
+
 	case *ast.AlterTableStmt:
 		err = c.alterTable(n)
 
@@ -288,6 +470,9 @@ func (c *Catalog) Update(stmt ast.Statement) error {
 	case *ast.AlterTypeAddValueStmt:
 		err = c.alterTypeAddValue(n)
 
+	case *ast.AlterTypeSetSchemaStmt:
+		err = c.alterTypeSetSchema(n)
+
 	case *ast.AlterTypeRenameValueStmt:
 		err = c.alterTypeRenameValue(n)
 
@@ -306,9 +491,15 @@ func (c *Catalog) Update(stmt ast.Statement) error {
 	case *ast.CompositeTypeStmt:
 		err = c.createCompositeType(n)
 
+	case *ast.CreateDomainStmt:
+		err = c.createDomain(n)
+
 	case *ast.CreateEnumStmt:
 		err = c.createEnum(n)
 
+	case *ast.CreateRangeStmt:
+		err = c.createRange(n)
+
 	case *ast.CreateExtensionStmt:
 		err = c.createExtension(n)
 
@@ -321,6 +512,9 @@ func (c *Catalog) Update(stmt ast.Statement) error {
 	case *ast.CreateTableStmt:
 		err = c.createTable(n)
 
+	case *ast.IndexStmt:
+		err = c.createIndex(n)
+
 	case *ast.DropFunctionStmt:
 		err = c.dropFunction(n)
 
@@ -333,12 +527,24 @@ func (c *Catalog) Update(stmt ast.Statement) error {
 	case *ast.DropTypeStmt:
 		err = c.dropType(n)
 
+	case *ast.RenameConstraintStmt:
+		err = c.renameConstraint(n)
+
+	case *ast.RenameIndexStmt:
+		err = c.renameIndex(n)
+
 	case *ast.RenameColumnStmt:
 		err = c.renameColumn(n)
 
+	case *ast.RenameTypeStmt:
+		err = c.renameType(n)
+
 	case *ast.RenameTableStmt:
 		err = c.renameTable(n)
 
+	case *ast.UseStmt:
+		err = c.useSchema(n)
+
 	}
 	return err
 }
@@ -0,0 +1,245 @@
+package catalog
+
+import (
+	"math"
+	"strings"
+
+	"github.com/kyleconroy/sqlc/internal/sql/ast"
+)
+
+// CanonicalType maps the different spellings of a built-in type to a single
+// name, so that `integer`, `int` and `pg_catalog.int4` compare as equal.
+func CanonicalType(dt string) string {
+	name := strings.ToLower(strings.TrimPrefix(dt, "pg_catalog."))
+	switch name {
+	case "smallint", "int2", "smallserial", "serial2", "tinyint":
+		return "int2"
+	case "integer", "int", "int4", "serial", "serial4", "mediumint":
+		return "int4"
+	case "bigint", "int8", "bigserial", "serial8":
+		return "int8"
+	case "decimal", "dec", "fixed":
+		return "numeric"
+	case "real", "float4":
+		return "float4"
+	case "float", "double precision", "double", "float8":
+		return "float8"
+	case "boolean", "bool":
+		return "bool"
+	case "character varying", "varchar":
+		return "varchar"
+	case "character", "char", "bpchar":
+		return "bpchar"
+	case "string", "tinytext", "mediumtext", "longtext":
+		return "text"
+	case "timestamp without time zone", "timestamp", "datetime":
+		return "timestamp"
+	case "timestamp with time zone", "timestamptz":
+		return "timestamptz"
+	case "time without time zone", "time":
+		return "time"
+	case "time with time zone", "timetz":
+		return "timetz"
+	}
+	return name
+}
+
+// TypeCategory returns the PostgreSQL type category (pg_type.typcategory)
+// of a built-in type, or 'U' for anything it doesn't know about. Untyped
+// values, such as string literals and parameters, are in category 'X'.
+//
+// https://www.postgresql.org/docs/current/catalog-pg-type.html#CATALOG-TYPCATEGORY-TABLE
+func TypeCategory(dt string) byte {
+	switch CanonicalType(dt) {
+	case "bool":
+		return 'B'
+	case "int2", "int4", "int8", "numeric", "float4", "float8", "money", "oid":
+		return 'N'
+	case "text", "varchar", "bpchar", "name", "citext":
+		return 'S'
+	case "date", "time", "timetz", "timestamp", "timestamptz":
+		return 'D'
+	case "interval":
+		return 'T'
+	case "inet", "cidr":
+		return 'I'
+	case "any", "unknown":
+		return 'X'
+	}
+	return 'U'
+}
+
+// typePrecedence orders types inside a category. When two values of the same
+// category meet, the one with the higher precedence wins, mirroring the
+// implicit casts PostgreSQL allows between them.
+var typePrecedence = map[string]int{
+	"int2":        1,
+	"int4":        2,
+	"int8":        3,
+	"oid":         3,
+	"numeric":     4,
+	"money":       4,
+	"float4":      5,
+	"float8":      6,
+	"bpchar":      1,
+	"varchar":     2,
+	"name":        2,
+	"citext":      2,
+	"text":        3,
+	"date":        1,
+	"time":        1,
+	"timetz":      2,
+	"timestamp":   2,
+	"timestamptz": 3,
+	"cidr":        1,
+	"inet":        2,
+}
+
+// IsPreferredType reports whether a type is the preferred type of its
+// category, which wins ties during function and operator resolution.
+func IsPreferredType(dt string) bool {
+	switch CanonicalType(dt) {
+	case "bool", "float8", "text", "timestamptz", "interval", "inet", "oid":
+		return true
+	}
+	return false
+}
+
+// CommonType reports which of two types a value of both types resolves to.
+// It returns 0 if the left type wins, 1 if the right type wins, and false if
+// the types can't be matched.
+//
+// https://www.postgresql.org/docs/current/typeconv-union-case.html
+func CommonType(a, b string) (int, bool) {
+	ca, cb := CanonicalType(a), CanonicalType(b)
+	if ca == cb {
+		return 0, true
+	}
+	cata, catb := TypeCategory(a), TypeCategory(b)
+	switch {
+	case cata == 'X':
+		return 1, true
+	case catb == 'X':
+		return 0, true
+	case cata != catb || cata == 'U':
+		return 0, false
+	}
+	if typePrecedence[cb] > typePrecedence[ca] {
+		return 1, true
+	}
+	return 0, true
+}
+
+// CanCoerce reports whether a value of type from can be implicitly converted
+// to type to.
+func CanCoerce(from, to string) bool {
+	if isPolymorphic(to) || TypeCategory(from) == 'X' {
+		return true
+	}
+	cf, ct := CanonicalType(from), CanonicalType(to)
+	if cf == ct {
+		return true
+	}
+	catf := TypeCategory(from)
+	if catf == 'U' || catf != TypeCategory(to) {
+		return false
+	}
+	// All string types convert to each other
+	if catf == 'S' {
+		return true
+	}
+	return typePrecedence[cf] <= typePrecedence[ct]
+}
+
+func isPolymorphic(dt string) bool {
+	switch strings.Trim(dt, "\"") {
+	case "any", "anyelement", "anyarray", "anynonarray", "anyenum", "anyrange",
+		"anymultirange", "anycompatible", "anycompatiblearray", "anycompatiblenonarray":
+		return true
+	}
+	return false
+}
+
+// typeString returns the name of a type and whether it is an array.
+func typeString(tn *ast.TypeName) (string, bool) {
+	if tn == nil {
+		return "unknown", false
+	}
+	name := tn.Name
+	if tn.Schema != "" && tn.Schema != "pg_catalog" {
+		name = tn.Schema + "." + name
+	}
+	array := tn.ArrayBounds != nil && len(tn.ArrayBounds.Items) > 0
+	if strings.HasSuffix(name, "[]") {
+		name = strings.TrimSuffix(name, "[]")
+		array = true
+	}
+	return name, array
+}
+
+// parseTypeString is the inverse of typeString for types that aren't arrays.
+func parseTypeString(name string) *ast.TypeName {
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		return &ast.TypeName{Schema: name[:i], Name: name[i+1:]}
+	}
+	return &ast.TypeName{Name: name}
+}
+
+// DisplayType formats a type the way PostgreSQL does in error messages.
+func DisplayType(tn *ast.TypeName) string {
+	name, array := typeString(tn)
+	switch CanonicalType(name) {
+	case "int2":
+		name = "smallint"
+	case "int4":
+		name = "integer"
+	case "int8":
+		name = "bigint"
+	case "float4":
+		name = "real"
+	case "float8":
+		name = "double precision"
+	case "bool":
+		name = "boolean"
+	case "varchar":
+		name = "character varying"
+	case "bpchar":
+		name = "character"
+	case "timestamp":
+		name = "timestamp without time zone"
+	case "timestamptz":
+		name = "timestamp with time zone"
+	case "time":
+		name = "time without time zone"
+	case "timetz":
+		name = "time with time zone"
+	case "any":
+		name = "unknown"
+	}
+	if array {
+		return name + "[]"
+	}
+	return name
+}
+
+// ExprType returns the type of a literal or a type cast, or nil if the type
+// can't be determined without looking at the tables of a query.
+func ExprType(node ast.Node) *ast.TypeName {
+	switch n := node.(type) {
+	case *ast.A_Const:
+		switch v := n.Val.(type) {
+		case *ast.Integer:
+			if v.Ival > math.MaxInt32 || v.Ival < math.MinInt32 {
+				return &ast.TypeName{Name: "bigint"}
+			}
+			return &ast.TypeName{Name: "integer"}
+		case *ast.Float:
+			return &ast.TypeName{Name: "numeric"}
+		}
+	case *ast.NamedArgExpr:
+		return ExprType(n.Arg)
+	case *ast.TypeCast:
+		return n.TypeName
+	}
+	return nil
+}
@@ -32,7 +32,24 @@ func (c *Catalog) ListFuncsByName(rel *ast.FuncName) ([]Function, error) {
 	return funcs, nil
 }
 
+// ResolveFuncCall finds the function a call refers to, using the types of
+// literals and casts among the arguments to choose between overloads.
 func (c *Catalog) ResolveFuncCall(call *ast.FuncCall) (*Function, error) {
+	var types []*ast.TypeName
+	if call.Args != nil {
+		for _, arg := range call.Args.Items {
+			types = append(types, ExprType(arg))
+		}
+	}
+	return c.ResolveFuncCallTypes(call, types)
+}
+
+// ResolveFuncCallTypes finds the function a call refers to, given the types
+// of its arguments. A nil type marks an argument whose type is unknown, such
+// as a parameter or a string literal.
+//
+// https://www.postgresql.org/docs/current/typeconv-func.html
+func (c *Catalog) ResolveFuncCallTypes(call *ast.FuncCall, types []*ast.TypeName) (*Function, error) {
 	// Do not validate unknown functions
 	funs, err := c.ListFuncsByName(call.Func)
 	if err != nil || len(funs) == 0 {
@@ -40,13 +57,19 @@ func (c *Catalog) ResolveFuncCall(call *ast.FuncCall) (*Function, error) {
 	}
 
 	// https://www.postgresql.org/docs/current/sql-syntax-calling-funcs.html
-	var positional []ast.Node
+	var positional []*ast.TypeName
 	var named []*ast.NamedArgExpr
+	var namedTypes []*ast.TypeName
 
 	if call.Args != nil {
-		for _, arg := range call.Args.Items {
+		for i, arg := range call.Args.Items {
+			var tn *ast.TypeName
+			if i < len(types) {
+				tn = types[i]
+			}
 			if narg, ok := arg.(*ast.NamedArgExpr); ok {
 				named = append(named, narg)
+				namedTypes = append(namedTypes, tn)
 			} else {
 				// The mixed notation combines positional and named notation.
 				// However, as already mentioned, named arguments cannot precede
@@ -58,16 +81,19 @@ func (c *Catalog) ResolveFuncCall(call *ast.FuncCall) (*Function, error) {
 						Location: call.Pos(),
 					}
 				}
-				positional = append(positional, arg)
+				positional = append(positional, tn)
 			}
 		}
 	}
 
-	for _, fun := range funs {
+	var best, fallback *Function
+	var bestScore funcScore
+	for i := range funs {
+		fun := &funs[i]
 		args := fun.InArgs()
 		var defaults int
 		var variadic bool
-		known := map[string]struct{}{}
+		known := map[string]*Argument{}
 		for _, arg := range args {
 			if arg.HasDefault {
 				defaults += 1
@@ -77,7 +103,7 @@ func (c *Catalog) ResolveFuncCall(call *ast.FuncCall) (*Function, error) {
 				defaults += 1
 			}
 			if arg.Name != "" {
-				known[arg.Name] = struct{}{}
+				known[arg.Name] = arg
 			}
 		}
 
@@ -107,12 +133,56 @@ func (c *Catalog) ResolveFuncCall(call *ast.FuncCall) (*Function, error) {
 			continue
 		}
 
-		return &fun, nil
+		// Check that each argument can be converted to the declared type
+		var score funcScore
+		match := true
+		for j, tn := range positional {
+			var declared *ast.TypeName
+			if arg := fun.CallArg(j); arg != nil {
+				declared = arg.Type
+			}
+			if !c.scoreArg(&score, tn, declared) {
+				match = false
+			}
+		}
+		for j, expr := range named {
+			if expr.Name == nil {
+				continue
+			}
+			if !c.scoreArg(&score, namedTypes[j], known[*expr.Name].Type) {
+				match = false
+			}
+		}
+		if !match {
+			if fallback == nil {
+				fallback = fun
+			}
+			continue
+		}
+
+		if best == nil || score.better(bestScore) {
+			best, bestScore = fun, score
+		}
+	}
+	if best != nil {
+		return best, nil
+	}
+	// sqlc doesn't know about every implicit cast, so a call that matches a
+	// function by arity but not by argument types isn't treated as an error
+	if fallback != nil {
+		return fallback, nil
 	}
 
 	var sig []string
-	for range call.Args.Items {
-		sig = append(sig, "unknown")
+	for _, tn := range positional {
+		sig = append(sig, DisplayType(tn))
+	}
+	for j, expr := range named {
+		if expr.Name != nil {
+			sig = append(sig, *expr.Name+" => "+DisplayType(namedTypes[j]))
+		} else {
+			sig = append(sig, DisplayType(namedTypes[j]))
+		}
 	}
 
 	return nil, &sqlerr.Error{
@@ -123,6 +193,175 @@ func (c *Catalog) ResolveFuncCall(call *ast.FuncCall) (*Function, error) {
 	}
 }
 
+// funcScore ranks the candidates for a function or operator call.
+// Candidates needing fewer conversions sqlc can't vouch for win, then those
+// with more exact matches, then those preferring the preferred type of a
+// category, then those taking unknown arguments as strings.
+type funcScore struct {
+	loose     int
+	exact     int
+	preferred int
+	strings   int
+}
+
+func (s funcScore) better(o funcScore) bool {
+	if s.loose != o.loose {
+		return s.loose < o.loose
+	}
+	if s.exact != o.exact {
+		return s.exact > o.exact
+	}
+	if s.preferred != o.preferred {
+		return s.preferred > o.preferred
+	}
+	return s.strings > o.strings
+}
+
+// scoreArg reports whether an argument of type actual can be passed to a
+// parameter of type declared, and adds to the score of the candidate.
+func (c *Catalog) scoreArg(score *funcScore, actual, declared *ast.TypeName) bool {
+	if declared == nil {
+		return true
+	}
+	want, wantArray := typeString(declared)
+	if actual == nil {
+		if TypeCategory(want) == 'S' {
+			score.strings++
+		}
+		return true
+	}
+	have, haveArray := typeString(c.baseType(actual))
+	if want == "anyrange" || want == "anymultirange" {
+		r, multi := c.LookupRange(parseTypeString(have))
+		if r == nil || haveArray || multi != (want == "anymultirange") {
+			score.loose++
+			return c.LooseTypes
+		}
+		return true
+	}
+	if isPolymorphic(want) {
+		if want == "anyarray" && !haveArray {
+			score.loose++
+			return c.LooseTypes
+		}
+		return true
+	}
+	if haveArray == wantArray && CanonicalType(have) == CanonicalType(want) {
+		score.exact++
+		return true
+	}
+	catHave, catWant := TypeCategory(have), TypeCategory(want)
+	if catHave == 'X' {
+		return true
+	}
+	if IsPreferredType(want) && catHave == catWant {
+		score.preferred++
+	}
+	// Types sqlc doesn't know about, such as enums, composite types and
+	// domains, are given the benefit of the doubt
+	if catHave == 'U' || catWant == 'U' {
+		score.loose++
+		return true
+	}
+	if haveArray == wantArray && CanCoerce(have, want) {
+		return true
+	}
+	score.loose++
+	return c.LooseTypes
+}
+
+// variadicElem returns the element type of a variadic parameter.
+func variadicElem(tn *ast.TypeName) *ast.TypeName {
+	if tn == nil {
+		return nil
+	}
+	name, array := typeString(tn)
+	if !array {
+		return tn
+	}
+	return &ast.TypeName{Name: name}
+}
+
+func (c *Catalog) ListOperatorsByName(name string) []*Operator {
+	var ops []*Operator
+	for _, ns := range c.schemasToSearch("") {
+		s, err := c.getSchema(ns)
+		if err != nil {
+			continue
+		}
+		for _, op := range s.Operators {
+			if op.Name == name {
+				ops = append(ops, op)
+			}
+		}
+	}
+	return ops
+}
+
+// ResolveOperator finds the operator an expression refers to, given the
+// types of its operands. A nil type marks an operand whose type is unknown.
+//
+// https://www.postgresql.org/docs/current/typeconv-oper.html
+func (c *Catalog) ResolveOperator(expr *ast.A_Expr, left, right *ast.TypeName) (*Operator, error) {
+	var name string
+	if expr.Name != nil && len(expr.Name.Items) > 0 {
+		// OPERATOR(pg_catalog.+) names the schema of the operator first
+		if s, ok := expr.Name.Items[len(expr.Name.Items)-1].(*ast.String); ok {
+			name = s.Str
+		}
+	}
+	prefix := expr.Lexpr == nil
+
+	var candidates []*Operator
+	var best *Operator
+	var bestScore funcScore
+	for _, op := range c.ListOperatorsByName(name) {
+		if (op.Left == nil) != prefix {
+			continue
+		}
+		candidates = append(candidates, op)
+		var score funcScore
+		if !prefix && !c.scoreArg(&score, left, op.Left) {
+			continue
+		}
+		if !c.scoreArg(&score, right, op.Right) {
+			continue
+		}
+		if best == nil || score.better(bestScore) {
+			best, bestScore = op, score
+		}
+	}
+	if best != nil {
+		return best, nil
+	}
+
+	// When every operator of that name returns the same type, such as the
+	// comparison operators, the result type is known even if sqlc can't
+	// tell which one is used
+	if len(candidates) > 0 {
+		rt := CanonicalType(candidates[0].ReturnType.Name)
+		same := true
+		for _, op := range candidates[1:] {
+			if CanonicalType(op.ReturnType.Name) != rt {
+				same = false
+			}
+		}
+		if same {
+			return candidates[0], nil
+		}
+	}
+
+	operands := []string{name, DisplayType(right)}
+	if !prefix {
+		operands = append([]string{DisplayType(left)}, operands...)
+	}
+	return nil, &sqlerr.Error{
+		Code:     "42883",
+		Message:  fmt.Sprintf("operator does not exist: %s", strings.Join(operands, " ")),
+		Location: expr.Location,
+	}
+}
+
 func (c *Catalog) GetTable(rel *ast.TableName) (Table, error) {
 	_, table, err := c.getTable(rel)
 	if table == nil {
@@ -131,3 +370,8 @@ func (c *Catalog) GetTable(rel *ast.TableName) (Table, error) {
 		return *table, err
 	}
 }
+
+func (c *Catalog) GetType(rel *ast.TypeName) (Type, error) {
+	typ, _, err := c.getType(rel)
+	return typ, err
+}
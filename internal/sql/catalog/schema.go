@@ -15,6 +15,7 @@ func (c *Catalog) createSchema(stmt *ast.CreateSchemaStmt) error {
 		if !stmt.IfNotExists {
 			return sqlerr.SchemaExists(*stmt.Name)
 		}
+		return nil
 	}
 	c.Schemas = append(c.Schemas, &Schema{Name: *stmt.Name})
 	return nil
@@ -39,3 +40,13 @@ func (c *Catalog) dropSchema(stmt *ast.DropSchemaStmt) error {
 	}
 	return nil
 }
+
+// useSchema makes the schema the default for the statements that follow
+// it, up to the end of the file.
+func (c *Catalog) useSchema(stmt *ast.UseStmt) error {
+	if _, err := c.getSchema(stmt.Schema); err != nil {
+		return err
+	}
+	c.DefaultSchema = stmt.Schema
+	return nil
+}
@@ -3,6 +3,8 @@ package catalog
 import (
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/kyleconroy/sqlc/internal/sql/ast"
 	"github.com/kyleconroy/sqlc/internal/sql/sqlerr"
@@ -24,16 +26,29 @@ func (c *Catalog) alterTable(stmt *ast.AlterTableStmt) error {
 				implemented = true
 			case ast.AT_SetNotNull:
 				implemented = true
+			case ast.AT_ColumnDefault:
+				implemented = true
+			case ast.AT_AddConstraint:
+				implemented = true
+			case ast.AT_DropConstraint:
+				implemented = true
+			case ast.AT_ChangeColumn:
+				implemented = true
+			case ast.AT_RenameColumn:
+				implemented = true
 			}
 		}
 	}
 	if !implemented {
 		return nil
 	}
-	_, table, err := c.getTable(stmt.Table)
+	schema, table, err := c.getTable(stmt.Table)
 	if err != nil {
 		return err
 	}
+	// The types of ENUM and SET columns are created in the table's schema,
+	// which may not be the default one anymore
+	rel := &ast.TableName{Schema: schema.Name, Name: table.Rel.Name}
 
 	for _, cmd := range stmt.Cmds.Items {
 		switch cmd := cmd.(type) {
@@ -43,8 +58,11 @@ func (c *Catalog) alterTable(stmt *ast.AlterTableStmt) error {
 			// Lookup column names for column-related commands
 			switch cmd.Subtype {
 			case ast.AT_AlterColumnType,
+				ast.AT_ChangeColumn,
+				ast.AT_ColumnDefault,
 				ast.AT_DropColumn,
 				ast.AT_DropNotNull,
+				ast.AT_RenameColumn,
 				ast.AT_SetNotNull:
 				for i, c := range table.Columns {
 					if c.Name == *cmd.Name {
@@ -64,28 +82,73 @@ func (c *Catalog) alterTable(stmt *ast.AlterTableStmt) error {
 			switch cmd.Subtype {
 
 			case ast.AT_AddColumn:
+				exists := false
 				for _, c := range table.Columns {
 					if c.Name == cmd.Def.Colname {
-						return sqlerr.ColumnExists(table.Rel.Name, c.Name)
+						exists = true
+					}
+				}
+				if exists && cmd.MissingOk {
+					continue
+				}
+				if exists {
+					return sqlerr.ColumnExists(table.Rel.Name, cmd.Def.Colname)
+				}
+				col, err := c.defineColumn(rel, cmd.Def)
+				if err != nil {
+					return err
+				}
+				table.Columns = append(table.Columns, col)
+				if cmd.Def.Constraints != nil {
+					for _, item := range cmd.Def.Constraints.Items {
+						if con, ok := item.(*ast.Constraint); ok {
+							c.defineConstraint(table, con, []string{cmd.Def.Colname})
+						}
 					}
 				}
-				table.Columns = append(table.Columns, &Column{
-					Name:      cmd.Def.Colname,
-					Type:      *cmd.Def.TypeName,
-					IsNotNull: cmd.Def.IsNotNull,
-					IsArray:   cmd.Def.IsArray,
-				})
+
+			case ast.AT_AddConstraint:
+				if err := c.addConstraint(table, cmd.Constraint, nil); err != nil {
+					return err
+				}
 
 			case ast.AT_AlterColumnType:
 				table.Columns[idx].Type = *cmd.Def.TypeName
 				table.Columns[idx].IsArray = cmd.Def.IsArray
 
+			case ast.AT_ChangeColumn:
+				if err := c.changeColumn(rel, table, idx, cmd.Def); err != nil {
+					return err
+				}
+
+			case ast.AT_ColumnDefault:
+				// Defaults don't change the type of a column
+
 			case ast.AT_DropColumn:
+				if err := c.dropColumnConstraints(table, *cmd.Name, cmd.Behavior); err != nil {
+					return err
+				}
+				c.dropColumnType(table.Rel, table.Columns[idx])
 				table.Columns = append(table.Columns[:idx], table.Columns[idx+1:]...)
 
+			case ast.AT_DropConstraint:
+				i := table.constraintIndex(*cmd.Name)
+				if i < 0 && (cmd.MissingOk || table.UnknownConstraints) {
+					continue
+				}
+				if i < 0 {
+					return sqlerr.ConstraintNotFound(table.Rel.Name, *cmd.Name)
+				}
+				table.Constraints = append(table.Constraints[:i], table.Constraints[i+1:]...)
+
 			case ast.AT_DropNotNull:
 				table.Columns[idx].IsNotNull = false
 
+			case ast.AT_RenameColumn:
+				if err := c.renameTableColumn(table, idx, *cmd.NewName); err != nil {
+					return err
+				}
+
 			case ast.AT_SetNotNull:
 				table.Columns[idx].IsNotNull = true
 
@@ -106,7 +169,9 @@ func (c *Catalog) alterTableSetSchema(stmt *ast.AlterTableSetSchemaStmt) error {
 		return err
 	}
 	tbl, idx, err := oldSchema.getTable(stmt.Table)
-	if err != nil {
+	if errors.Is(err, sqlerr.NotFound) && stmt.MissingOk {
+		return nil
+	} else if err != nil {
 		return err
 	}
 	newSchema, err := c.getSchema(*stmt.NewSchema)
@@ -154,30 +219,314 @@ func (c *Catalog) createTable(stmt *ast.CreateTableStmt) error {
 		}
 	} else {
 		for _, col := range stmt.Cols {
-			tc := &Column{
-				Name:      col.Colname,
-				Type:      *col.TypeName,
-				IsNotNull: col.IsNotNull,
-				IsArray:   col.IsArray,
-				Comment:   col.Comment,
+			tc, err := c.defineColumn(stmt.Name, col)
+			if err != nil {
+				return err
 			}
-			if col.Vals != nil {
-				typeName := ast.TypeName{
-					Name: fmt.Sprintf("%s_%s", stmt.Name.Name, col.Colname),
-				}
-				s := &ast.CreateEnumStmt{TypeName: &typeName, Vals: col.Vals}
-				if err := c.createEnum(s); err != nil {
-					return err
+			tbl.Columns = append(tbl.Columns, tc)
+			if col.Constraints != nil {
+				for _, item := range col.Constraints.Items {
+					if con, ok := item.(*ast.Constraint); ok {
+						c.defineConstraint(&tbl, con, []string{col.Colname})
+					}
 				}
-				tc.Type = typeName
 			}
-			tbl.Columns = append(tbl.Columns, tc)
+		}
+		for _, con := range stmt.Constraints {
+			c.defineConstraint(&tbl, con, nil)
 		}
 	}
 	schema.Tables = append(schema.Tables, &tbl)
 	return nil
 }
 
+// defineColumn returns the column for a column definition, creating the
+// type of an ENUM or SET column.
+func (c *Catalog) defineColumn(rel *ast.TableName, def *ast.ColumnDef) (*Column, error) {
+	col := &Column{
+		Name:      def.Colname,
+		Type:      *def.TypeName,
+		IsNotNull: def.IsNotNull,
+		IsArray:   def.IsArray,
+		Comment:   def.Comment,
+		Unsigned:  def.Unsigned,
+		Length:    def.Length,
+		Generated: def.Generated,
+	}
+	if def.Vals != nil {
+		typeName, err := c.createColumnType(rel, def)
+		if err != nil {
+			return nil, err
+		}
+		col.Type = typeName
+	}
+	return col, nil
+}
+
+// defineConstraint records a table constraint. Column constraints don't list
+// their keys, so the column they're defined on is passed instead.
+func (c *Catalog) defineConstraint(t *Table, con *ast.Constraint, cols []string) {
+	switch con.Contype {
+	case ast.CONSTR_PRIMARY, ast.CONSTR_UNIQUE, ast.CONSTR_CHECK, ast.CONSTR_FOREIGN, ast.CONSTR_EXCLUSION:
+	default:
+		return
+	}
+	if con.Keys != nil && len(con.Keys.Items) > 0 {
+		cols = stringSlice(con.Keys)
+	} else if con.FkAttrs != nil && len(con.FkAttrs.Items) > 0 {
+		cols = stringSlice(con.FkAttrs)
+	} else if con.Exclusions != nil && len(con.Exclusions.Items) > 0 {
+		cols = exclusionColumns(con.Exclusions)
+	}
+	var name string
+	switch {
+	case con.Conname != nil:
+		name = *con.Conname
+	case con.Contype == ast.CONSTR_UNIQUE && c.ColumnIndexNames && len(cols) > 0:
+		name = t.indexName(cols[0])
+	default:
+		// PostgreSQL also avoids the names of other relations and of the
+		// constraints of other tables, and names a CHECK constraint after
+		// the columns of its expression, so a generated name is a guess
+		name = t.constraintName(con.Contype, cols)
+		t.UnknownConstraints = true
+	}
+	tc := &Constraint{
+		Name:    name,
+		Type:    con.Contype,
+		Columns: cols,
+	}
+	if con.Contype == ast.CONSTR_FOREIGN && con.Pktable != nil && con.Pktable.Relname != nil {
+		tc.RefTable = &ast.TableName{Name: *con.Pktable.Relname}
+		if con.Pktable.Schemaname != nil {
+			tc.RefTable.Schema = *con.Pktable.Schemaname
+		}
+		if con.PkAttrs != nil {
+			tc.RefColumns = stringSlice(con.PkAttrs)
+		}
+	}
+	t.Constraints = append(t.Constraints, tc)
+}
+
+// addConstraint adds a constraint to an existing table, checking that its
+// name is unique and that its columns exist. As for defineConstraint, the
+// column of a column constraint is passed.
+func (c *Catalog) addConstraint(table *Table, con *ast.Constraint, cols []string) error {
+	if con.Conname != nil && table.constraintIndex(*con.Conname) >= 0 {
+		return sqlerr.ConstraintExists(table.Rel.Name, *con.Conname)
+	}
+	n := len(table.Constraints)
+	c.defineConstraint(table, con, cols)
+	if len(table.Constraints) == n {
+		return nil
+	}
+	added := table.Constraints[n]
+	for _, name := range added.Columns {
+		col := findColumn(table, name)
+		if col == nil {
+			table.Constraints = table.Constraints[:n]
+			return sqlerr.ColumnNotFound(table.Rel.Name, name)
+		}
+		// Columns of a primary key can't be null
+		if added.Type == ast.CONSTR_PRIMARY {
+			col.IsNotNull = true
+		}
+	}
+	return nil
+}
+
+// dropColumnConstraints removes the constraints that involve a column that
+// is being dropped. Foreign keys of other tables that reference the column
+// are only removed by DROP COLUMN ... CASCADE.
+func (c *Catalog) dropColumnConstraints(table *Table, col string, behavior ast.DropBehavior) error {
+	for _, schema := range c.Schemas {
+		for _, other := range schema.Tables {
+			var kept []*Constraint
+			for _, con := range other.Constraints {
+				switch {
+				case other == table && contains(con.Columns, col):
+				case con.Type == ast.CONSTR_FOREIGN && c.references(con, table, col):
+					if behavior != ast.DROP_CASCADE {
+						return &sqlerr.Error{
+							Code:    "2BP01",
+							Message: fmt.Sprintf("cannot drop column %s of table %s because other objects depend on it", col, table.Rel.Name),
+						}
+					}
+				default:
+					kept = append(kept, con)
+				}
+			}
+			other.Constraints = kept
+		}
+	}
+	return nil
+}
+
+// references reports whether a foreign key references a column of a table.
+func (c *Catalog) references(con *Constraint, table *Table, col string) bool {
+	if con.RefTable == nil {
+		return false
+	}
+	_, ref, err := c.getTable(con.RefTable)
+	if err != nil || ref != table {
+		return false
+	}
+	cols := con.RefColumns
+	if len(cols) == 0 {
+		for _, pk := range table.Constraints {
+			if pk.Type == ast.CONSTR_PRIMARY {
+				cols = pk.Columns
+			}
+		}
+	}
+	return contains(cols, col)
+}
+
+func (t *Table) constraintIndex(name string) int {
+	for i, con := range t.Constraints {
+		if con.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func findColumn(t *Table, name string) *Column {
+	for _, col := range t.Columns {
+		if col.Name == name {
+			return col
+		}
+	}
+	return nil
+}
+
+func contains(items []string, item string) bool {
+	for _, i := range items {
+		if i == item {
+			return true
+		}
+	}
+	return false
+}
+
+// indexName returns the name MySQL gives to an unnamed index, that of its
+// first column. A number from 2 up is added to the name if another index of
+// the table has it, or if it's PRIMARY, the name of the primary key.
+func (t *Table) indexName(col string) string {
+	name := col
+	for i := 2; t.constraintIndex(name) >= 0 || strings.EqualFold(name, "PRIMARY"); i++ {
+		name = col + "_" + strconv.Itoa(i)
+	}
+	return name
+}
+
+// constraintName returns the name PostgreSQL gives to an unnamed constraint.
+// A number is added to the name if another constraint of the table has it.
+func (t *Table) constraintName(contype ast.ConstrType, cols []string) string {
+	var label string
+	switch contype {
+	case ast.CONSTR_PRIMARY:
+		label = "pkey"
+		cols = nil
+	case ast.CONSTR_UNIQUE:
+		label = "key"
+	case ast.CONSTR_CHECK:
+		label = "check"
+	case ast.CONSTR_FOREIGN:
+		label = "fkey"
+	case ast.CONSTR_EXCLUSION:
+		label = "excl"
+	}
+	name := objectName(t.Rel.Name, strings.Join(cols, "_"), label)
+	for i := 1; t.constraintIndex(name) >= 0; i++ {
+		name = objectName(t.Rel.Name, strings.Join(cols, "_"), label+strconv.Itoa(i))
+	}
+	return name
+}
+
+// exclusionColumns returns the columns of the elements of an EXCLUDE
+// constraint, each of which is listed with its operator.
+func exclusionColumns(exclusions *ast.List) []string {
+	var cols []string
+	for _, item := range exclusions.Items {
+		pair, ok := item.(*ast.List)
+		if !ok || len(pair.Items) == 0 {
+			continue
+		}
+		if elem, ok := pair.Items[0].(*ast.IndexElem); ok && elem.Name != nil {
+			cols = append(cols, *elem.Name)
+		}
+	}
+	return cols
+}
+
+// maxIdentifierLength is the length of the longest name PostgreSQL keeps.
+// Longer names are truncated.
+const maxIdentifierLength = 63
+
+// objectName joins the parts of a generated name, shortening the longer of
+// the first two until it fits, as PostgreSQL's makeObjectName does.
+func objectName(name1, name2, label string) string {
+	avail := maxIdentifierLength - len(label) - 1
+	if name2 != "" {
+		avail--
+	}
+	n1, n2 := len(name1), len(name2)
+	for n1+n2 > avail {
+		if n1 > n2 {
+			n1--
+		} else {
+			n2--
+		}
+	}
+	parts := []string{name1[:n1]}
+	if name2 != "" {
+		parts = append(parts, name2[:n2])
+	}
+	return strings.Join(append(parts, label), "_")
+}
+
+// createIndex records unique indexes, which can be used to infer the
+// arbiter of an ON CONFLICT clause. Other indexes don't affect the types of
+// queries, so they're ignored.
+func (c *Catalog) createIndex(stmt *ast.IndexStmt) error {
+	if !stmt.Unique || stmt.Relation == nil || stmt.Relation.Relname == nil {
+		return nil
+	}
+	rel := &ast.TableName{Name: *stmt.Relation.Relname}
+	if stmt.Relation.Schemaname != nil {
+		rel.Schema = *stmt.Relation.Schemaname
+	}
+	_, table, err := c.getTable(rel)
+	if errors.Is(err, sqlerr.NotFound) {
+		// Indexes can be created on relations the catalog doesn't track as
+		// tables, such as materialized views
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var cols []string
+	for _, item := range stmt.IndexParams.Items {
+		elem, ok := item.(*ast.IndexElem)
+		if !ok || elem.Name == nil {
+			// Expression indexes can't be matched against column names
+			return nil
+		}
+		cols = append(cols, *elem.Name)
+	}
+	name := strings.Join(append(append([]string{rel.Name}, cols...), "idx"), "_")
+	if stmt.Idxname != nil {
+		name = *stmt.Idxname
+	}
+	table.Constraints = append(table.Constraints, &Constraint{
+		Name:    name,
+		Type:    ast.CONSTR_UNIQUE,
+		Columns: cols,
+	})
+	return nil
+}
+
 func (c *Catalog) dropTable(stmt *ast.DropTableStmt) error {
 	for _, name := range stmt.Tables {
 		ns := name.Schema
@@ -191,13 +540,16 @@ func (c *Catalog) dropTable(stmt *ast.DropTableStmt) error {
 			return err
 		}
 
-		_, idx, err := schema.getTable(name)
+		tbl, idx, err := schema.getTable(name)
 		if errors.Is(err, sqlerr.NotFound) && stmt.IfExists {
 			continue
 		} else if err != nil {
 			return err
 		}
 
+		for _, col := range tbl.Columns {
+			c.dropColumnType(tbl.Rel, col)
+		}
 		schema.Tables = append(schema.Tables[:idx], schema.Tables[idx+1:]...)
 	}
 	return nil
@@ -213,14 +565,122 @@ func (c *Catalog) renameColumn(stmt *ast.RenameColumnStmt) error {
 		if tbl.Columns[i].Name == stmt.Col.Name {
 			idx = i
 		}
-		if tbl.Columns[i].Name == *stmt.NewName {
-			return sqlerr.ColumnExists(tbl.Rel.Name, *stmt.NewName)
-		}
 	}
 	if idx == -1 {
 		return sqlerr.ColumnNotFound(tbl.Rel.Name, stmt.Col.Name)
 	}
-	tbl.Columns[idx].Name = *stmt.NewName
+	return c.renameTableColumn(tbl, idx, *stmt.NewName)
+}
+
+// renameTableColumn renames the column at idx, along with the constraints
+// on it and the foreign keys that reference it.
+func (c *Catalog) renameTableColumn(tbl *Table, idx int, name string) error {
+	old := tbl.Columns[idx].Name
+	if old == name {
+		return nil
+	}
+	if findColumn(tbl, name) != nil {
+		return sqlerr.ColumnExists(tbl.Rel.Name, name)
+	}
+	for _, con := range tbl.Constraints {
+		renameItem(con.Columns, old, name)
+	}
+	for _, schema := range c.Schemas {
+		for _, other := range schema.Tables {
+			for _, con := range other.Constraints {
+				if con.Type == ast.CONSTR_FOREIGN && c.references(con, tbl, old) {
+					renameItem(con.RefColumns, old, name)
+				}
+			}
+		}
+	}
+	c.renameColumnType(tbl.Rel, tbl.Columns[idx], name)
+	tbl.Columns[idx].Name = name
+	return nil
+}
+
+// changeColumn replaces the column at idx with a new definition, which may
+// also rename it, as MySQL's CHANGE COLUMN and MODIFY COLUMN do. The table
+// is in the schema of rel.
+func (c *Catalog) changeColumn(rel *ast.TableName, tbl *Table, idx int, def *ast.ColumnDef) error {
+	if def.Colname != tbl.Columns[idx].Name && findColumn(tbl, def.Colname) != nil {
+		return sqlerr.ColumnExists(tbl.Rel.Name, def.Colname)
+	}
+	c.dropColumnType(tbl.Rel, tbl.Columns[idx])
+	if err := c.renameTableColumn(tbl, idx, def.Colname); err != nil {
+		return err
+	}
+	col, err := c.defineColumn(rel, def)
+	if err != nil {
+		return err
+	}
+	tbl.Columns[idx] = col
+	if def.Constraints != nil {
+		for _, item := range def.Constraints.Items {
+			if con, ok := item.(*ast.Constraint); ok {
+				if err := c.addConstraint(tbl, con, []string{def.Colname}); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func renameItem(items []string, old, name string) {
+	for i := range items {
+		if items[i] == old {
+			items[i] = name
+		}
+	}
+}
+
+func (c *Catalog) renameConstraint(stmt *ast.RenameConstraintStmt) error {
+	_, tbl, err := c.getTable(stmt.Table)
+	if errors.Is(err, sqlerr.NotFound) && stmt.MissingOk {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	idx := tbl.constraintIndex(*stmt.Constraint)
+	if idx < 0 {
+		// Not every constraint is tracked by the catalog
+		return nil
+	}
+	if tbl.constraintIndex(*stmt.NewName) >= 0 {
+		return sqlerr.ConstraintExists(tbl.Rel.Name, *stmt.NewName)
+	}
+	tbl.Constraints[idx].Name = *stmt.NewName
+	return nil
+}
+
+// renameIndex renames the primary key, unique or exclusion constraint that
+// an index belongs to. Other indexes aren't tracked by the catalog.
+func (c *Catalog) renameIndex(stmt *ast.RenameIndexStmt) error {
+	ns := stmt.Index.Schema
+	if ns == "" {
+		ns = c.DefaultSchema
+	}
+	schema, err := c.getSchema(ns)
+	if errors.Is(err, sqlerr.NotFound) && stmt.MissingOk {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, tbl := range schema.Tables {
+		idx := tbl.constraintIndex(stmt.Index.Name)
+		if idx < 0 {
+			continue
+		}
+		switch tbl.Constraints[idx].Type {
+		case ast.CONSTR_PRIMARY, ast.CONSTR_UNIQUE, ast.CONSTR_EXCLUSION:
+			if tbl.constraintIndex(*stmt.NewName) >= 0 {
+				return sqlerr.ConstraintExists(tbl.Rel.Name, *stmt.NewName)
+			}
+			tbl.Constraints[idx].Name = *stmt.NewName
+			return nil
+		}
+	}
 	return nil
 }
 
@@ -3,6 +3,7 @@ package catalog
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/kyleconroy/sqlc/internal/sql/ast"
 	"github.com/kyleconroy/sqlc/internal/sql/sqlerr"
@@ -37,6 +38,91 @@ func (c *Catalog) createEnum(stmt *ast.CreateEnumStmt) error {
 	return nil
 }
 
+// createColumnType creates the type of a column declared with a list of
+// values, such as a MySQL ENUM or SET column. The type is named after the
+// table and the column, in the table's schema.
+func (c *Catalog) createColumnType(rel *ast.TableName, col *ast.ColumnDef) (ast.TypeName, error) {
+	ns := rel.Schema
+	if ns == "" {
+		ns = c.DefaultSchema
+	}
+	typeName := ast.TypeName{
+		Schema: ns,
+		Name:   fmt.Sprintf("%s_%s", rel.Name, col.Colname),
+	}
+	schema, err := c.getSchema(ns)
+	if err != nil {
+		return typeName, err
+	}
+	if _, _, err := schema.getType(&typeName); err == nil {
+		return typeName, sqlerr.TypeExists(typeName.Name)
+	}
+	if col.TypeName != nil && col.TypeName.Name == "set" {
+		schema.Types = append(schema.Types, &Set{
+			Name: typeName.Name,
+			Vals: stringSlice(col.Vals),
+		})
+	} else {
+		schema.Types = append(schema.Types, &Enum{
+			Name:   typeName.Name,
+			Vals:   stringSlice(col.Vals),
+			column: true,
+		})
+	}
+	return typeName, nil
+}
+
+// columnType returns the type createColumnType created for a column, if
+// there is one, and the schema it's in.
+func (c *Catalog) columnType(rel *ast.TableName, col *Column) (*Schema, int, bool) {
+	if col.Type.Name != fmt.Sprintf("%s_%s", rel.Name, col.Name) {
+		return nil, -1, false
+	}
+	schema, err := c.getSchema(col.Type.Schema)
+	if err != nil {
+		return nil, -1, false
+	}
+	typ, idx, err := schema.getType(&col.Type)
+	if err != nil {
+		return nil, -1, false
+	}
+	switch t := typ.(type) {
+	case *Enum:
+		return schema, idx, t.column
+	case *Set:
+		return schema, idx, true
+	default:
+		return nil, -1, false
+	}
+}
+
+// dropColumnType drops the type createColumnType created for a column, if
+// there is one.
+func (c *Catalog) dropColumnType(rel *ast.TableName, col *Column) {
+	schema, idx, ok := c.columnType(rel, col)
+	if !ok {
+		return
+	}
+	schema.Types = append(schema.Types[:idx], schema.Types[idx+1:]...)
+}
+
+// renameColumnType renames the type createColumnType created for a column,
+// if there is one, to match the column's new name.
+func (c *Catalog) renameColumnType(rel *ast.TableName, col *Column, name string) {
+	schema, idx, ok := c.columnType(rel, col)
+	if !ok {
+		return
+	}
+	newName := fmt.Sprintf("%s_%s", rel.Name, name)
+	switch t := schema.Types[idx].(type) {
+	case *Enum:
+		t.Name = newName
+	case *Set:
+		t.Name = newName
+	}
+	col.Type.Name = newName
+}
+
 func (c *Catalog) createCompositeType(stmt *ast.CompositeTypeStmt) error {
 	ns := stmt.TypeName.Schema
 	if ns == "" {
@@ -59,9 +145,208 @@ func (c *Catalog) createCompositeType(stmt *ast.CompositeTypeStmt) error {
 	if _, _, err := schema.getType(stmt.TypeName); err == nil {
 		return sqlerr.TypeExists(tbl.Name)
 	}
-	schema.Types = append(schema.Types, &CompositeType{
+	ct := &CompositeType{
 		Name: stmt.TypeName.Name,
-	})
+	}
+	for _, col := range stmt.Cols {
+		ct.Columns = append(ct.Columns, &Column{
+			Name:    col.Colname,
+			Type:    *col.TypeName,
+			IsArray: col.IsArray,
+		})
+	}
+	schema.Types = append(schema.Types, ct)
+	return nil
+}
+
+func (c *Catalog) createRange(stmt *ast.CreateRangeStmt) error {
+	ns := stmt.TypeName.Schema
+	if ns == "" {
+		ns = c.DefaultSchema
+	}
+	schema, err := c.getSchema(ns)
+	if err != nil {
+		return err
+	}
+	if stmt.Subtype == nil {
+		return &sqlerr.Error{
+			Code:    "42P17",
+			Message: "type attribute \"subtype\" is required",
+		}
+	}
+	mr := &ast.TypeName{Name: MultirangeName(stmt.TypeName.Name)}
+	if stmt.MultirangeTypeName != nil {
+		mr = stmt.MultirangeTypeName
+	}
+	for _, name := range []string{stmt.TypeName.Name, mr.Name} {
+		if _, _, err := schema.getTable(&ast.TableName{Name: name}); err == nil {
+			return sqlerr.RelationExists(name)
+		}
+		if _, _, err := schema.getType(&ast.TypeName{Name: name}); err == nil {
+			return sqlerr.TypeExists(name)
+		}
+	}
+	schema.Types = append(schema.Types,
+		&Range{Name: stmt.TypeName.Name, Subtype: *stmt.Subtype},
+		&Multirange{Name: mr.Name, Range: stmt.TypeName.Name},
+	)
+	return nil
+}
+
+// MultirangeName returns the name PostgreSQL gives to the multirange type of
+// a range type: "range" in the name is replaced by "multirange", otherwise
+// "_multirange" is appended.
+func MultirangeName(name string) string {
+	if strings.Contains(name, "range") {
+		return strings.Replace(name, "range", "multirange", 1)
+	}
+	return name + "_multirange"
+}
+
+// LookupRange returns the range type of a range or multirange type, and
+// whether the type is a multirange.
+func (c *Catalog) LookupRange(tn *ast.TypeName) (*Range, bool) {
+	if tn == nil {
+		return nil, false
+	}
+	if tn.Schema == "" {
+		tn = parseTypeString(tn.Name)
+	}
+	for _, ns := range c.schemasToSearch(tn.Schema) {
+		s, err := c.getSchema(ns)
+		if err != nil {
+			continue
+		}
+		typ, _, err := s.getType(tn)
+		if err != nil {
+			continue
+		}
+		switch t := typ.(type) {
+		case *Range:
+			return t, false
+		case *Multirange:
+			r, _ := c.LookupRange(&ast.TypeName{Schema: ns, Name: t.Range})
+			return r, r != nil
+		}
+	}
+	return nil, false
+}
+
+func (c *Catalog) createDomain(stmt *ast.CreateDomainStmt) error {
+	ns := stmt.Domainname.Schema
+	if ns == "" {
+		ns = c.DefaultSchema
+	}
+	schema, err := c.getSchema(ns)
+	if err != nil {
+		return err
+	}
+	tbl := &ast.TableName{
+		Name: stmt.Domainname.Name,
+	}
+	if _, _, err := schema.getTable(tbl); err == nil {
+		return sqlerr.RelationExists(tbl.Name)
+	}
+	if _, _, err := schema.getType(stmt.Domainname); err == nil {
+		return sqlerr.TypeExists(tbl.Name)
+	}
+	d := &Domain{
+		Name:     stmt.Domainname.Name,
+		BaseType: *stmt.TypeName,
+		IsArray:  stmt.IsArray,
+	}
+	if stmt.Constraints != nil {
+		for _, item := range stmt.Constraints.Items {
+			if con, ok := item.(*ast.Constraint); ok {
+				d.addConstraint(con)
+			}
+		}
+	}
+	schema.Types = append(schema.Types, d)
+	return nil
+}
+
+// addConstraint records a constraint of a domain. Unnamed CHECK constraints
+// are named after the domain, as PostgreSQL does.
+func (d *Domain) addConstraint(con *ast.Constraint) {
+	switch con.Contype {
+	case ast.CONSTR_NOTNULL:
+		d.NotNull = true
+	case ast.CONSTR_NULL:
+		d.NotNull = false
+	case ast.CONSTR_CHECK:
+		name := d.Name + "_check"
+		if con.Conname != nil {
+			name = *con.Conname
+		}
+		d.Constraints = append(d.Constraints, &Constraint{
+			Name: name,
+			Type: con.Contype,
+		})
+	}
+}
+
+// baseType returns the base type of a domain, or the type itself if it
+// isn't a domain.
+func (c *Catalog) baseType(tn *ast.TypeName) *ast.TypeName {
+	typ, _, err := c.getType(tn)
+	if err != nil {
+		return tn
+	}
+	d, ok := typ.(*Domain)
+	if !ok || d.IsArray {
+		return tn
+	}
+	return &d.BaseType
+}
+
+func (c *Catalog) alterDomain(stmt *ast.AlterDomainStmt) error {
+	typ, _, err := c.getType(stmt.TypeName)
+	if err != nil {
+		return err
+	}
+	d, ok := typ.(*Domain)
+	if !ok {
+		return fmt.Errorf("type is not a domain: %s", stmt.TypeName.Name)
+	}
+	// https://www.postgresql.org/docs/current/sql-alterdomain.html
+	switch stmt.Subtype {
+	case 'N': // DROP NOT NULL
+		d.NotNull = false
+	case 'O': // SET NOT NULL
+		d.NotNull = true
+	case 'C': // ADD CONSTRAINT
+		con, ok := stmt.Def.(*ast.Constraint)
+		if !ok {
+			return nil
+		}
+		if con.Conname != nil {
+			for _, existing := range d.Constraints {
+				if existing.Name == *con.Conname {
+					return &sqlerr.Error{
+						Err:     sqlerr.Exists,
+						Code:    "42710",
+						Message: fmt.Sprintf("constraint \"%s\" for domain \"%s\"", *con.Conname, d.Name),
+					}
+				}
+			}
+		}
+		d.addConstraint(con)
+	case 'X': // DROP CONSTRAINT
+		for i, con := range d.Constraints {
+			if con.Name == *stmt.Name {
+				d.Constraints = append(d.Constraints[:i], d.Constraints[i+1:]...)
+				return nil
+			}
+		}
+		if !stmt.MissingOk {
+			return &sqlerr.Error{
+				Err:     sqlerr.NotFound,
+				Code:    "42704",
+				Message: fmt.Sprintf("constraint \"%s\" of domain \"%s\"", *stmt.Name, d.Name),
+			}
+		}
+	}
 	return nil
 }
 
@@ -162,3 +447,100 @@ func (c *Catalog) dropType(stmt *ast.DropTypeStmt) error {
 	}
 	return nil
 }
+
+func (c *Catalog) renameType(stmt *ast.RenameTypeStmt) error {
+	ns := stmt.Type.Schema
+	if ns == "" {
+		ns = c.DefaultSchema
+	}
+	schema, err := c.getSchema(ns)
+	if err != nil {
+		return err
+	}
+	typ, _, err := schema.getType(stmt.Type)
+	if err != nil {
+		return err
+	}
+	if _, _, err := schema.getType(&ast.TypeName{Name: *stmt.NewName}); err == nil {
+		return sqlerr.TypeExists(*stmt.NewName)
+	}
+	if _, _, err := schema.getTable(&ast.TableName{Name: *stmt.NewName}); err == nil {
+		return sqlerr.RelationExists(*stmt.NewName)
+	}
+	switch t := typ.(type) {
+	case *CompositeType:
+		t.Name = *stmt.NewName
+	case *Domain:
+		t.Name = *stmt.NewName
+	case *Enum:
+		t.Name = *stmt.NewName
+	case *Multirange:
+		t.Name = *stmt.NewName
+	case *Range:
+		t.Name = *stmt.NewName
+		for _, other := range schema.Types {
+			if mr, ok := other.(*Multirange); ok && mr.Range == stmt.Type.Name {
+				mr.Range = t.Name
+			}
+		}
+	}
+	c.updateTypeRefs(ns, stmt.Type.Name, ns, *stmt.NewName)
+	return nil
+}
+
+func (c *Catalog) alterTypeSetSchema(stmt *ast.AlterTypeSetSchemaStmt) error {
+	ns := stmt.Type.Schema
+	if ns == "" {
+		ns = c.DefaultSchema
+	}
+	oldSchema, err := c.getSchema(ns)
+	if err != nil {
+		return err
+	}
+	typ, idx, err := oldSchema.getType(stmt.Type)
+	if err != nil {
+		return err
+	}
+	newSchema, err := c.getSchema(*stmt.NewSchema)
+	if err != nil {
+		return err
+	}
+	if _, _, err := newSchema.getType(stmt.Type); err == nil {
+		return sqlerr.TypeExists(stmt.Type.Name)
+	}
+	oldSchema.Types = append(oldSchema.Types[:idx], oldSchema.Types[idx+1:]...)
+	newSchema.Types = append(newSchema.Types, typ)
+	c.updateTypeRefs(ns, stmt.Type.Name, newSchema.Name, stmt.Type.Name)
+	return nil
+}
+
+// updateTypeRefs changes the type of every column, or field of a composite
+// type, that refers to a type that has been renamed or moved to another
+// schema.
+func (c *Catalog) updateTypeRefs(oldSchema, oldName, newSchema, newName string) {
+	var cols []*Column
+	for _, schema := range c.Schemas {
+		for _, table := range schema.Tables {
+			cols = append(cols, table.Columns...)
+		}
+		for _, typ := range schema.Types {
+			if ct, ok := typ.(*CompositeType); ok {
+				cols = append(cols, ct.Columns...)
+			}
+		}
+	}
+	for _, col := range cols {
+		ns := col.Type.Schema
+		if ns == "" {
+			ns = c.DefaultSchema
+		}
+		if ns != oldSchema || col.Type.Name != oldName {
+			continue
+		}
+		col.Type.Name = newName
+		if newSchema == c.DefaultSchema && col.Type.Schema == "" {
+			continue
+		}
+		col.Type.Schema = newSchema
+	}
+}
@@ -48,8 +48,6 @@ func NamedParameters(engine config.Engine, raw *ast.RawStmt) (*ast.RawStmt, map[
 		return raw, map[int]string{}, nil
 	}
 
-	hasNamedParameterSupport := engine != config.EngineMySQL
-
 	args := map[string]int{}
 	argn := 0
 	var edits []source.Edit
@@ -60,7 +58,7 @@ func NamedParameters(engine config.Engine, raw *ast.RawStmt) (*ast.RawStmt, map[
 		case named.IsParamFunc(node):
 			fun := node.(*ast.FuncCall)
 			param, isConst := flatten(fun.Args)
-			if num, ok := args[param]; ok && hasNamedParameterSupport {
+			if num, ok := args[param]; ok {
 				cr.Replace(&ast.ParamRef{
 					Number:   num,
 					Location: fun.Location,
@@ -80,7 +78,7 @@ func NamedParameters(engine config.Engine, raw *ast.RawStmt) (*ast.RawStmt, map[
 			} else {
 				old = fmt.Sprintf("sqlc.arg(%s)", param)
 			}
-			if engine == config.EngineMySQL {
+			if engine == config.EngineMySQL || engine == config.EngineMariaDB {
 				replace = "?"
 			} else {
 				replace = fmt.Sprintf("$%d", args[param])
@@ -8,6 +8,7 @@ import (
 var Exists = errors.New("already exists")
 var NotFound = errors.New("does not exist")
 var NotUnique = errors.New("is not unique")
+var Ambiguous = errors.New("is ambiguous")
 
 type Error struct {
 	Err      error
@@ -47,6 +48,14 @@ func ColumnNotFound(rel, col string) *Error {
 	}
 }
 
+func ColumnAmbiguous(col string) *Error {
+	return &Error{
+		Err:     Ambiguous,
+		Code:    "42702",
+		Message: fmt.Sprintf("column reference \"%s\"", col),
+	}
+}
+
 func RelationExists(rel string) *Error {
 	return &Error{
 		Err:     Exists,
@@ -109,3 +118,19 @@ func FunctionNotUnique(fn string) *Error {
 		Message: fmt.Sprintf("function name \"%s\"", fn),
 	}
 }
+
+func ConstraintExists(rel, con string) *Error {
+	return &Error{
+		Err:     Exists,
+		Code:    "42710",
+		Message: fmt.Sprintf("constraint \"%s\" for relation \"%s\"", con, rel),
+	}
+}
+
+func ConstraintNotFound(rel, con string) *Error {
+	return &Error{
+		Err:     NotFound,
+		Code:    "42704",
+		Message: fmt.Sprintf("constraint \"%s\" of relation \"%s\"", con, rel),
+	}
+}
@@ -21,6 +21,8 @@ func Cmd(n ast.Node, name, cmd string) error {
 		list = stmt.ReturningList
 	case *ast.UpdateStmt:
 		list = stmt.ReturningList
+	case *ast.CreateTableStmt, *ast.LockStmt, *ast.NotifyStmt, *ast.RefreshMatViewStmt, *ast.VacuumStmt, *ast.VariableSetStmt:
+		return fmt.Errorf("query %q specifies parameter %q for a statement that doesn't return rows", name, cmd)
 	default:
 		return nil
 	}
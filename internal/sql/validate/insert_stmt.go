@@ -10,6 +10,10 @@ func InsertStmt(stmt *ast.InsertStmt) error {
 	if !ok {
 		return nil
 	}
+	// Without a column list, values are assigned to the table's columns
+	if stmt.Cols == nil || len(stmt.Cols.Items) == 0 {
+		return nil
+	}
 	if sel.ValuesLists == nil {
 		return nil
 	}
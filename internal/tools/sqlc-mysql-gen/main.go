@@ -0,0 +1,952 @@
+package main
+
+import (
+	"bytes"
+	"database/sql"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+
+	_ "github.com/go-sql-driver/mysql"
+
+	"github.com/kyleconroy/sqlc/internal/sql/ast"
+	"github.com/kyleconroy/sqlc/internal/sql/catalog"
+)
+
+// The help tables are loaded with the server's documentation, which starts
+// each topic with the syntax of the statement or function it describes.
+//
+// https://dev.mysql.com/doc/refman/8.0/en/server-side-help-support.html
+const helpTopics = `
+SELECT t.name, t.description, c.name
+FROM mysql.help_topic t
+JOIN mysql.help_category c ON c.help_category_id = t.help_category_id
+ORDER BY t.name
+`
+
+// Functions of this help category are aggregate functions. All of them but
+// COUNT return NULL when there are no rows.
+//
+// https://dev.mysql.com/doc/refman/8.0/en/aggregate-functions.html
+const aggregateCategory = "Aggregate Functions and Modifiers"
+
+const catalogTmpl = `
+// Code generated by sqlc-mysql-gen. DO NOT EDIT.
+
+package dolphin
+
+import (
+	"github.com/kyleconroy/sqlc/internal/sql/ast"
+	"github.com/kyleconroy/sqlc/internal/sql/catalog"
+)
+
+func defaultSchema(name string) *catalog.Schema {
+	s := &catalog.Schema{Name: name}
+	s.Funcs = []*catalog.Function{
+	    {{- range .}}
+		{
+			Name: "{{.Name}}",
+			Args: []*catalog.Argument{
+				{{range .Args}}{
+				Type: &ast.TypeName{Name: "{{.Type.Name}}"},
+				{{- if .HasDefault}}
+				HasDefault: true,
+				{{- end}}
+				{{- if variadic .}}
+				Mode: ast.FuncParamVariadic,
+				{{- end}}
+				},
+				{{end}}
+			},
+			ReturnType: &ast.TypeName{Name: "{{.ReturnType.Name}}"},
+			{{- if .ReturnTypeNullable}}
+			ReturnTypeNullable: true,
+			{{- end}}
+			{{- if .Strict}}
+			Strict: true,
+			{{- end}}
+		},
+		{{- end}}
+	}
+	return s
+}
+`
+
+// Each argument is probed with a column of the samples table, named after
+// its type.
+const samplesTable = `
+CREATE TABLE samples (
+  c_tinyint   TINYINT,
+  c_smallint  SMALLINT,
+  c_mediumint MEDIUMINT,
+  c_int       INT,
+  c_bigint    BIGINT,
+  c_decimal   DECIMAL(10, 2),
+  c_float     FLOAT,
+  c_double    DOUBLE,
+  c_date      DATE,
+  c_datetime  DATETIME,
+  c_timestamp TIMESTAMP NULL,
+  c_time      TIME,
+  c_year      YEAR,
+  c_text      TEXT,
+  c_binary    VARBINARY(255),
+  c_json      JSON,
+  c_geometry  GEOMETRY
+)
+`
+
+// argTypes maps the names the documentation gives arguments to the type of
+// the sample passed for them. Arguments with other names, like expr and
+// value, can be of any type.
+var argTypes = map[string]string{
+	// Strings
+	"str":            "text",
+	"substr":         "text",
+	"newstr":         "text",
+	"remstr":         "text",
+	"from_str":       "text",
+	"to_str":         "text",
+	"format":         "text",
+	"separator":      "text",
+	"pat":            "text",
+	"repl":           "text",
+	"match_type":     "text",
+	"search_str":     "text",
+	"one_or_all":     "text",
+	"escape_char":    "text",
+	"path":           "text",
+	"key":            "text",
+	"key_str":        "text",
+	"crypt_str":      "text",
+	"init_vector":    "text",
+	"string_uuid":    "text",
+	"on":             "text",
+	"off":            "text",
+	"from_tz":        "text",
+	"to_tz":          "text",
+	"name":           "text",
+	"xml_frag":       "text",
+	"xpath_expr":     "text",
+	"new_xml":        "text",
+	"wkt":            "text",
+	"options":        "text",
+	"gtid_set":       "text",
+	"set":            "text",
+	"strlist":        "text",
+	"digest":         "text",
+	"statement":      "text",
+	"expr_ip":        "text",
+	"log_name":       "text",
+	"channel":        "text",
+	"geohash_str":    "text",
+	"strategy":       "text",
+	"type":           "text",
+	"binary_uuid":    "binary",
+	"wkb":            "binary",
+	"binary_address": "binary",
+
+	// Numbers
+	"n":              "int",
+	"m":              "int",
+	"d":              "int",
+	"pos":            "int",
+	"len":            "int",
+	"count":          "int",
+	"number":         "int",
+	"bits":           "int",
+	"number_of_bits": "int",
+	"fsp":            "int",
+	"from_base":      "int",
+	"to_base":        "int",
+	"days":           "int",
+	"dayofyear":      "int",
+	"year":           "int",
+	"hour":           "int",
+	"minute":         "int",
+	"second":         "int",
+	"seconds":        "int",
+	"mode":           "int",
+	"occurrence":     "int",
+	"return_option":  "int",
+	"srid":           "int",
+	"timeout":        "int",
+	"duration":       "int",
+	"swap_flag":      "int",
+	"max_length":     "int",
+	"x":              "double",
+	"y":              "double",
+	"distance":       "double",
+	"fraction":       "double",
+	"max_distance":   "double",
+	"unix_timestamp": "bigint",
+	"picoseconds":    "bigint",
+
+	// Dates and times
+	"date":          "datetime",
+	"dt":            "datetime",
+	"datetime_expr": "datetime",
+	"time":          "time",
+	"period":        "int",
+
+	// JSON and spatial values
+	"json_doc": "json",
+	"json_val": "json",
+	"schema":   "json",
+	"document": "json",
+	"g":        "geometry",
+	"pt":       "geometry",
+	"ls":       "geometry",
+	"poly":     "geometry",
+	"mpt":      "geometry",
+	"mls":      "geometry",
+	"mpoly":    "geometry",
+	"gc":       "geometry",
+}
+
+// Arguments with these names can be of several types, and the result of
+// some functions, like ABS or SUM, depends on the type passed.
+var numeric = map[string]bool{
+	"n": true,
+	"m": true,
+	"d": true,
+	"x": true,
+	"y": true,
+}
+
+// Sample types tried for arguments of any type, and for numeric arguments.
+var anySamples = []string{"bigint", "decimal", "double", "datetime", "text"}
+var numericSamples = []string{"tinyint", "smallint", "mediumint", "int", "bigint", "decimal", "float", "double"}
+
+// typeNames maps the data types the server reports for a probed column to
+// the types of the catalog.
+var typeNames = map[string]string{
+	"char":       "text",
+	"varchar":    "text",
+	"tinytext":   "text",
+	"text":       "text",
+	"mediumtext": "text",
+	"longtext":   "text",
+	"binary":     "binary",
+	"varbinary":  "binary",
+	"tinyblob":   "binary",
+	"blob":       "binary",
+	"mediumblob": "binary",
+	"longblob":   "binary",
+	"tinyint":    "tinyint",
+	"smallint":   "smallint",
+	"mediumint":  "mediumint",
+	"int":        "int",
+	"bigint":     "bigint",
+	"decimal":    "decimal",
+	"float":      "float",
+	"double":     "double",
+	"date":       "date",
+	"datetime":   "datetime",
+	"timestamp":  "datetime",
+	"time":       "time",
+	"year":       "year",
+	"json":       "json",
+	"geometry":   "geometry",
+	"point":      "geometry",
+}
+
+// The server can't tell whether a function returns NULL for arguments that
+// aren't, as many do for invalid input, like STR_TO_DATE or DATE_FORMAT.
+// Only these functions are known to return NULL just for NULL arguments.
+var strict = map[string]bool{
+	"ABS":               true,
+	"ASCII":             true,
+	"ATAN":              true,
+	"ATAN2":             true,
+	"BIT_COUNT":         true,
+	"BIT_LENGTH":        true,
+	"CEIL":              true,
+	"CEILING":           true,
+	"CHAR":              true,
+	"CHARACTER_LENGTH":  true,
+	"CHAR_LENGTH":       true,
+	"CONCAT":            true,
+	"CONNECTION_ID":     true,
+	"COS":               true,
+	"CRC32":             true,
+	"CUME_DIST":         true,
+	"CURDATE":           true,
+	"CURRENT_DATE":      true,
+	"CURRENT_TIME":      true,
+	"CURRENT_TIMESTAMP": true,
+	"CURRENT_USER":      true,
+	"CURTIME":           true,
+	"DEGREES":           true,
+	"DENSE_RANK":        true,
+	"FIELD":             true,
+	"FIND_IN_SET":       true,
+	"FLOOR":             true,
+	"FOUND_ROWS":        true,
+	"HEX":               true,
+	"INSERT":            true,
+	"INSTR":             true,
+	"ISNULL":            true,
+	"JSON_ARRAY":        true,
+	"JSON_OBJECT":       true,
+	"JSON_QUOTE":        true,
+	"LAST_INSERT_ID":    true,
+	"LCASE":             true,
+	"LEFT":              true,
+	"LENGTH":            true,
+	"LOCALTIME":         true,
+	"LOCALTIMESTAMP":    true,
+	"LOCATE":            true,
+	"LOWER":             true,
+	"LTRIM":             true,
+	"MD5":               true,
+	"MID":               true,
+	"NOW":               true,
+	"NTILE":             true,
+	"OCTET_LENGTH":      true,
+	"ORD":               true,
+	"PERCENT_RANK":      true,
+	"PI":                true,
+	"POSITION":          true,
+	"QUOTE":             true,
+	"RADIANS":           true,
+	"RAND":              true,
+	"RANK":              true,
+	"REPLACE":           true,
+	"REVERSE":           true,
+	"RIGHT":             true,
+	"ROUND":             true,
+	"ROW_COUNT":         true,
+	"ROW_NUMBER":        true,
+	"RTRIM":             true,
+	"SESSION_USER":      true,
+	"SHA":               true,
+	"SHA1":              true,
+	"SIGN":              true,
+	"SIN":               true,
+	"SOUNDEX":           true,
+	"STRCMP":            true,
+	"SUBSTR":            true,
+	"SUBSTRING":         true,
+	"SUBSTRING_INDEX":   true,
+	"SYSDATE":           true,
+	"SYSTEM_USER":       true,
+	"TAN":               true,
+	"TO_BASE64":         true,
+	"TRIM":              true,
+	"TRUNCATE":          true,
+	"UCASE":             true,
+	"UPPER":             true,
+	"USER":              true,
+	"UTC_DATE":          true,
+	"UTC_TIME":          true,
+	"UTC_TIMESTAMP":     true,
+	"UUID":              true,
+	"UUID_SHORT":        true,
+	"VERSION":           true,
+}
+
+// The arguments of these functions are passed by the parser differently
+// from the way they're documented, so they're declared by hand.
+var manual = []catalog.Function{
+	{
+		// The separator is passed as the last argument
+		Name: "GROUP_CONCAT",
+		Args: []*catalog.Argument{
+			{Type: &ast.TypeName{Name: "any"}},
+			{Type: &ast.TypeName{Name: "any"}, Mode: ast.FuncParamVariadic},
+		},
+		ReturnType:         &ast.TypeName{Name: "text"},
+		ReturnTypeNullable: true,
+	},
+	{
+		Name: "TRIM",
+		Args: []*catalog.Argument{
+			{Type: &ast.TypeName{Name: "text"}},
+		},
+		ReturnType: &ast.TypeName{Name: "text"},
+	},
+	{
+		// TRIM(LEADING remstr FROM str) is passed as str, remstr, LEADING
+		Name: "TRIM",
+		Args: []*catalog.Argument{
+			{Type: &ast.TypeName{Name: "text"}},
+			{Type: &ast.TypeName{Name: "text"}},
+			{Type: &ast.TypeName{Name: "any"}, HasDefault: true},
+		},
+		ReturnType: &ast.TypeName{Name: "text"},
+	},
+	{
+		// Casts aren't function calls
+		Name: "CAST",
+		Args: []*catalog.Argument{
+			{Type: &ast.TypeName{Name: "any"}},
+		},
+		ReturnType: &ast.TypeName{Name: "any"},
+	},
+	{
+		Name: "CONVERT",
+		Args: []*catalog.Argument{
+			{Type: &ast.TypeName{Name: "any"}},
+			{Type: &ast.TypeName{Name: "any"}},
+		},
+		ReturnType: &ast.TypeName{Name: "any"},
+	},
+}
+
+func main() {
+	if err := run(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// A param is an argument of a function, as written in its syntax.
+type param struct {
+	// The text of the argument, with its name replaced by %s
+	text string
+	name string
+	// Arguments in the same part of a call, like expr and unit in
+	// DATE_ADD(date, INTERVAL expr unit), aren't separated by commas
+	joined   bool
+	optional bool
+	variadic bool
+}
+
+// base returns the name of the argument without a number, like str for
+// str1.
+func (p param) base() string {
+	return strings.TrimRight(strings.ToLower(p.name), "0123456789")
+}
+
+// unit is passed as a keyword, like DAY, rather than as a value.
+func (p param) unit() bool {
+	return p.name == "unit"
+}
+
+// typ returns the type of the sample passed for the argument.
+func (p param) typ() string {
+	if p.unit() {
+		return "any"
+	}
+	if t, ok := argTypes[p.base()]; ok {
+		return t
+	}
+	return "any"
+}
+
+// varies reports whether the argument can be of several types.
+func (p param) varies() bool {
+	if p.unit() {
+		return false
+	}
+	if numeric[p.base()] {
+		return true
+	}
+	_, ok := argTypes[p.base()]
+	return !ok
+}
+
+// A signature is one way of calling a function.
+type signature struct {
+	name      string
+	params    []param
+	window    bool
+	aggregate bool
+}
+
+var (
+	callStart = regexp.MustCompile(`\b([A-Z][A-Z0-9_]*)\(`)
+	argName   = regexp.MustCompile(`^([a-z_][a-z0-9_]*|[A-Z][0-9]*)$`)
+	keyword   = regexp.MustCompile(`^[A-Z_]{2,}$`)
+
+	// Modifiers, like [DISTINCT], and alternative keywords, like
+	// {BOTH | LEADING | TRAILING}, aren't arguments
+	modifier = regexp.MustCompile(`\[[A-Z_ ]+\]|\{[^}]*\}`)
+)
+
+// parseSyntax returns the function calls in the syntax section of a help
+// topic, which comes before the first blank line.
+func parseSyntax(desc string) []signature {
+	section := strings.SplitN(desc, "\n\n", 2)[0]
+	if !strings.HasPrefix(section, "Syntax:") {
+		return nil
+	}
+	section = strings.Join(strings.Fields(strings.TrimPrefix(section, "Syntax:")), " ")
+
+	var sigs []signature
+	for _, loc := range callStart.FindAllStringSubmatchIndex(section, -1) {
+		start := loc[1]
+		depth := 1
+		end := start
+		for ; end < len(section) && depth > 0; end++ {
+			switch section[end] {
+			case '(':
+				depth++
+			case ')':
+				depth--
+			}
+		}
+		if depth > 0 {
+			continue
+		}
+		params, ok := parseParams(section[start : end-1])
+		if !ok {
+			continue
+		}
+		sigs = append(sigs, signature{
+			name:   section[loc[2]:loc[3]],
+			params: params,
+			window: strings.Contains(section[end:], "over_clause"),
+		})
+	}
+	return sigs
+}
+
+// parseParams splits the arguments of a call on commas. Arguments within
+// square brackets are optional, and one followed by an ellipsis may be
+// repeated.
+func parseParams(args string) ([]param, bool) {
+	args = modifier.ReplaceAllString(args, "")
+	var params []param
+	var depth int
+	var optional bool
+	var current strings.Builder
+	flush := func() bool {
+		text := strings.TrimSpace(current.String())
+		current.Reset()
+		if text == "" {
+			return true
+		}
+		repeated := strings.HasSuffix(text, "...")
+		text = strings.TrimSpace(strings.TrimSuffix(text, "..."))
+		// A repeated argument is collected by a variadic argument, so
+		// further arguments, like in JSON_SEARCH(..., path[, path] ...),
+		// aren't needed
+		if len(params) > 0 && params[len(params)-1].variadic {
+			return true
+		}
+		if text == "" {
+			if !repeated || len(params) == 0 {
+				return false
+			}
+			// The argument before the ellipsis may be a second example of
+			// the repeated one, like str2 in CONCAT(str1,str2,...)
+			if n := len(params); n > 1 && params[n-1].base() == params[n-2].base() && !params[n-1].joined {
+				params = params[:n-1]
+			}
+			params[len(params)-1].variadic = true
+			return true
+		}
+		ps, ok := parseParam(text)
+		if !ok {
+			return false
+		}
+		for _, p := range ps {
+			p.optional = optional
+			// The second example of a repeated argument, like val in
+			// JSON_ARRAY([val[, val] ...]), is the same argument
+			if repeated && len(ps) == 1 && len(params) > 0 && params[len(params)-1].base() == p.base() {
+				break
+			}
+			params = append(params, p)
+		}
+		if repeated {
+			params[len(params)-1].variadic = true
+		}
+		return true
+	}
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case '[':
+			// Optional arguments, like pos in LOCATE(substr,str[,pos]),
+			// start with a comma. Other optional clauses, like RETURNING
+			// type in JSON_VALUE(json_doc, path [RETURNING type]), are left
+			// out.
+			rest := strings.TrimSpace(args[i+1:])
+			if strings.TrimSpace(current.String()) != "" && !strings.HasPrefix(rest, ",") {
+				end := matchingBracket(args, i)
+				if end < 0 {
+					return nil, false
+				}
+				i = end
+				continue
+			}
+			if !flush() {
+				return nil, false
+			}
+			optional = true
+			depth++
+		case ']':
+			depth--
+		case ',':
+			if !flush() {
+				return nil, false
+			}
+		default:
+			current.WriteByte(args[i])
+		}
+	}
+	if depth != 0 || !flush() {
+		return nil, false
+	}
+	return params, true
+}
+
+// matchingBracket returns the index of the bracket closing the one at i.
+func matchingBracket(s string, i int) int {
+	depth := 0
+	for ; i < len(s); i++ {
+		switch s[i] {
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// parseParam parses the arguments in the text between two commas, which
+// may include keywords, like INTERVAL expr unit.
+func parseParam(text string) ([]param, bool) {
+	var params []param
+	var prefix []string
+	for _, word := range strings.Fields(text) {
+		switch {
+		case keyword.MatchString(word):
+			prefix = append(prefix, word)
+		case argName.MatchString(word):
+			params = append(params, param{
+				text:   strings.Join(append(prefix, "%s"), " "),
+				name:   word,
+				joined: len(params) > 0,
+			})
+			prefix = nil
+		default:
+			return nil, false
+		}
+	}
+	if len(params) == 0 {
+		return nil, false
+	}
+	if len(prefix) > 0 {
+		last := &params[len(params)-1]
+		last.text += " " + strings.Join(prefix, " ")
+	}
+	return params, true
+}
+
+// call renders a call passing the given sample types.
+func (s signature) call(types []string) string {
+	var args strings.Builder
+	for i, t := range types {
+		value := "DAY"
+		if !s.params[i].unit() {
+			value = "c_" + t
+		}
+		switch {
+		case s.params[i].joined:
+			args.WriteString(" ")
+		case i > 0:
+			args.WriteString(", ")
+		}
+		args.WriteString(fmt.Sprintf(s.params[i].text, value))
+	}
+	call := s.name + "(" + args.String() + ")"
+	if s.window {
+		call += " OVER ()"
+	}
+	return call
+}
+
+type prober struct {
+	db *sql.DB
+}
+
+// returnType returns the type of the result of a call.
+func (p *prober) returnType(call string) (string, error) {
+	if _, err := p.db.Exec("DROP TABLE IF EXISTS probe"); err != nil {
+		return "", err
+	}
+	if _, err := p.db.Exec("CREATE TABLE probe AS SELECT " + call + " AS r FROM samples LIMIT 0"); err != nil {
+		return "", err
+	}
+	var dataType, columnType string
+	row := p.db.QueryRow(`
+		SELECT DATA_TYPE, COLUMN_TYPE
+		FROM information_schema.COLUMNS
+		WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'probe'`)
+	if err := row.Scan(&dataType, &columnType); err != nil {
+		return "", err
+	}
+	// Predicates return an integer of width one
+	if columnType == "int(1)" || columnType == "tinyint(1)" {
+		return "bool", nil
+	}
+	if name, ok := typeNames[dataType]; ok {
+		return name, nil
+	}
+	return "any", nil
+}
+
+// funcs probes a signature with each number of its optional arguments.
+// Arguments that can be left out are declared with defaults, unless leaving
+// them out changes the type of the result.
+func (p *prober) funcs(s signature) ([]catalog.Function, error) {
+	min := len(s.params)
+	for min > 0 && s.params[min-1].optional {
+		min--
+	}
+	var funcs, group []catalog.Function
+	var start int
+	for n := min; n <= len(s.params); n++ {
+		fs, err := p.overloads(signature{name: s.name, params: s.params[:n], window: s.window})
+		if err != nil {
+			// Some arguments documented as optional are required by the
+			// server, unless other optional arguments are passed
+			if n < len(s.params) {
+				continue
+			}
+			return nil, err
+		}
+		if group != nil && sameReturns(group, fs) {
+			for _, f := range fs {
+				for i := start; i < n; i++ {
+					f.Args[i].HasDefault = true
+				}
+			}
+			group = fs
+			continue
+		}
+		funcs = append(funcs, group...)
+		group, start = fs, n
+	}
+	return append(funcs, group...), nil
+}
+
+// overloads probes the return type of a signature. When the result has the
+// type of the arguments that can be of several types, it's declared with
+// anyelement. When it depends on them in another way, a function is
+// declared for each type.
+func (p *prober) overloads(s signature) ([]catalog.Function, error) {
+	base := make([]string, len(s.params))
+	for i, param := range s.params {
+		base[i] = param.typ()
+		if base[i] == "any" {
+			base[i] = "bigint"
+		}
+	}
+	ret, err := p.returnType(s.call(base))
+	if err != nil {
+		return nil, err
+	}
+
+	// Find the arguments the result depends on
+	var varying []int
+	samples := anySamples
+	for i, param := range s.params {
+		if !param.varies() {
+			continue
+		}
+		candidates := anySamples
+		if param.typ() != "any" {
+			candidates = numericSamples
+		}
+		for _, t := range candidates {
+			types := append([]string{}, base...)
+			types[i] = t
+			r, err := p.returnType(s.call(types))
+			if err != nil {
+				continue
+			}
+			if r != ret {
+				varying = append(varying, i)
+				if param.typ() != "any" {
+					samples = numericSamples
+				}
+				break
+			}
+		}
+	}
+
+	declare := func(types []string, ret string) catalog.Function {
+		f := catalog.Function{Name: s.name, ReturnType: &ast.TypeName{Name: ret}}
+		for i, param := range s.params {
+			t := param.typ()
+			for _, j := range varying {
+				if i == j {
+					t = types[i]
+				}
+			}
+			arg := &catalog.Argument{Type: &ast.TypeName{Name: t}}
+			if param.variadic {
+				arg.Mode = ast.FuncParamVariadic
+			}
+			f.Args = append(f.Args, arg)
+		}
+		return f
+	}
+	if len(varying) == 0 {
+		return []catalog.Function{declare(base, ret)}, nil
+	}
+
+	results := map[string]string{}
+	polymorphic := true
+	for _, t := range samples {
+		types := append([]string{}, base...)
+		for _, i := range varying {
+			types[i] = t
+		}
+		r, err := p.returnType(s.call(types))
+		if err != nil {
+			continue
+		}
+		results[t] = r
+		if r != typeNames[t] {
+			polymorphic = false
+		}
+	}
+	if polymorphic {
+		types := make([]string, len(s.params))
+		for _, i := range varying {
+			types[i] = "anyelement"
+		}
+		return []catalog.Function{declare(types, "anyelement")}, nil
+	}
+	var funcs []catalog.Function
+	for _, t := range samples {
+		r, ok := results[t]
+		if !ok {
+			continue
+		}
+		types := make([]string, len(s.params))
+		for _, i := range varying {
+			types[i] = t
+		}
+		funcs = append(funcs, declare(types, r))
+	}
+	return funcs, nil
+}
+
+// sameReturns reports whether the functions declared for a call return the
+// same types, for the same arguments, as those declared for a shorter call.
+func sameReturns(shorter, fs []catalog.Function) bool {
+	if len(shorter) != len(fs) {
+		return false
+	}
+	for i := range fs {
+		if fs[i].ReturnType.Name != shorter[i].ReturnType.Name {
+			return false
+		}
+		for j := range shorter[i].Args {
+			if fs[i].Args[j].Type.Name != shorter[i].Args[j].Type.Name {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func run() error {
+	tmpl, err := template.New("").Funcs(template.FuncMap{
+		"variadic": func(arg *catalog.Argument) bool {
+			return arg.Mode == ast.FuncParamVariadic
+		},
+	}).Parse(catalogTmpl)
+	if err != nil {
+		return err
+	}
+
+	// DATABASE_URL is a data source name for a scratch database, such as
+	// root:mysecretpassword@tcp(127.0.0.1:3306)/sqlc_mysql_gen
+	db, err := sql.Open("mysql", os.Getenv("DATABASE_URL"))
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	// The probe table is recreated for each call, so every statement must
+	// run on the same connection
+	db.SetMaxOpenConns(1)
+
+	if _, err := db.Exec("DROP TABLE IF EXISTS samples"); err != nil {
+		return err
+	}
+	if _, err := db.Exec(samplesTable); err != nil {
+		return err
+	}
+	defer db.Exec("DROP TABLE IF EXISTS samples, probe")
+
+	rows, err := db.Query(helpTopics)
+	if err != nil {
+		return err
+	}
+	var sigs []signature
+	for rows.Next() {
+		var name, desc, category string
+		if err := rows.Scan(&name, &desc, &category); err != nil {
+			rows.Close()
+			return err
+		}
+		for _, s := range parseSyntax(desc) {
+			s.aggregate = category == aggregateCategory
+			sigs = append(sigs, s)
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	declared := map[string]bool{}
+	for _, f := range manual {
+		declared[f.Name] = true
+	}
+	seen := map[string]bool{}
+	funcs := append([]catalog.Function{}, manual...)
+	for _, s := range sigs {
+		// The same syntax can appear in more than one topic
+		key := fmt.Sprintf("%s%v", s.name, s.params)
+		if declared[s.name] || seen[key] {
+			continue
+		}
+		seen[key] = true
+		p := prober{db: db}
+		fs, err := p.funcs(s)
+		if err != nil {
+			log.Printf("skipping %s: %s", s.name, err)
+			continue
+		}
+		if s.aggregate && s.name != "COUNT" {
+			for i := range fs {
+				fs[i].ReturnTypeNullable = true
+			}
+		}
+		funcs = append(funcs, fs...)
+	}
+	for i := range funcs {
+		funcs[i].Strict = strict[funcs[i].Name]
+	}
+	sort.SliceStable(funcs, func(i, j int) bool {
+		return funcs[i].Name < funcs[j].Name
+	})
+
+	out := bytes.NewBuffer([]byte{})
+	if err := tmpl.Execute(out, funcs); err != nil {
+		return err
+	}
+	code, err := format.Source(out.Bytes())
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join("internal", "engine", "dolphin", "stdlib.go"), code, 0644)
+}
@@ -0,0 +1,190 @@
+// Package pginterval parses PostgreSQL intervals for the Interval types of
+// the code sqlc generates.
+package pginterval
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// An Interval is a PostgreSQL interval. Months and days are kept apart from
+// the time of day because their length varies with the date they're added to.
+type Interval struct {
+	Months       int32
+	Days         int32
+	Microseconds int64
+}
+
+// Parse parses the text format of an interval in any of the PostgreSQL
+// interval styles.
+//
+// https://www.postgresql.org/docs/current/datatype-datetime.html#DATATYPE-INTERVAL-OUTPUT
+func Parse(s string) (Interval, error) {
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "P") {
+		return parseISOInterval(s)
+	}
+	var i Interval
+	ago := false
+	fields := strings.Fields(s)
+	for n := 0; n < len(fields); n++ {
+		f := fields[n]
+		switch {
+		case f == "@":
+		case f == "ago":
+			ago = true
+		case strings.Contains(f, ":"):
+			us, err := parseIntervalTime(f)
+			if err != nil {
+				return Interval{}, fmt.Errorf("invalid interval: %q", s)
+			}
+			i.Microseconds += us
+		case strings.Contains(f[1:], "-"):
+			// The year-month field of the sql_standard style, such as 1-2
+			sign := 1
+			if f[0] == '-' || f[0] == '+' {
+				if f[0] == '-' {
+					sign = -1
+				}
+				f = f[1:]
+			}
+			parts := strings.SplitN(f, "-", 2)
+			years, err := strconv.Atoi(parts[0])
+			if err != nil {
+				return Interval{}, fmt.Errorf("invalid interval: %q", s)
+			}
+			months, err := strconv.Atoi(parts[1])
+			if err != nil {
+				return Interval{}, fmt.Errorf("invalid interval: %q", s)
+			}
+			i.Months += int32(sign * (years*12 + months))
+		default:
+			// A number without a unit is a day count if a time follows
+			// it, and a second count otherwise
+			unit := "second"
+			if n+1 < len(fields) {
+				next := fields[n+1]
+				if next != "ago" && strings.IndexFunc(next[:1], unicode.IsLetter) == 0 {
+					unit = next
+					n++
+				} else if strings.Contains(next, ":") {
+					unit = "day"
+				}
+			}
+			if err := addIntervalField(&i, f, unit); err != nil {
+				return Interval{}, fmt.Errorf("invalid interval: %q", s)
+			}
+		}
+	}
+	if ago {
+		i = Interval{Months: -i.Months, Days: -i.Days, Microseconds: -i.Microseconds}
+	}
+	return i, nil
+}
+
+// parseISOInterval parses an interval in the iso_8601 style, such as
+// P1Y2M3DT4H5M6S.
+func parseISOInterval(s string) (Interval, error) {
+	var i Interval
+	var num strings.Builder
+	timeOfDay := false
+	for _, c := range s[1:] {
+		var unit string
+		switch {
+		case c == 'T':
+			timeOfDay = true
+			continue
+		case c == '-' || c == '+' || c == '.' || unicode.IsDigit(c):
+			num.WriteRune(c)
+			continue
+		case c == 'Y':
+			unit = "year"
+		case c == 'M' && timeOfDay:
+			unit = "minute"
+		case c == 'M':
+			unit = "month"
+		case c == 'W':
+			unit = "week"
+		case c == 'D':
+			unit = "day"
+		case c == 'H':
+			unit = "hour"
+		case c == 'S':
+			unit = "second"
+		default:
+			return Interval{}, fmt.Errorf("invalid interval: %q", s)
+		}
+		if err := addIntervalField(&i, num.String(), unit); err != nil {
+			return Interval{}, fmt.Errorf("invalid interval: %q", s)
+		}
+		num.Reset()
+	}
+	if num.Len() > 0 {
+		return Interval{}, fmt.Errorf("invalid interval: %q", s)
+	}
+	return i, nil
+}
+
+// parseIntervalTime parses the time of day of an interval, such as -04:05:06.5,
+// into microseconds.
+func parseIntervalTime(s string) (int64, error) {
+	sign := int64(1)
+	if s[0] == '-' || s[0] == '+' {
+		if s[0] == '-' {
+			sign = -1
+		}
+		s = s[1:]
+	}
+	parts := strings.Split(s, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, fmt.Errorf("invalid time: %q", s)
+	}
+	hours, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	minutes, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	var seconds float64
+	if len(parts) == 3 {
+		if seconds, err = strconv.ParseFloat(parts[2], 64); err != nil {
+			return 0, err
+		}
+	}
+	return sign * (hours*3600e6 + minutes*60e6 + int64(math.Round(seconds*1e6))), nil
+}
+
+func addIntervalField(i *Interval, value, unit string) error {
+	v, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return err
+	}
+	switch strings.TrimSuffix(strings.ToLower(unit), "s") {
+	case "year":
+		i.Months += int32(math.Round(v * 12))
+	case "mon", "month":
+		i.Months += int32(v)
+	case "week":
+		i.Days += int32(v * 7)
+	case "day":
+		i.Days += int32(v)
+	case "hour":
+		i.Microseconds += int64(math.Round(v * 3600e6))
+	case "min", "minute":
+		i.Microseconds += int64(math.Round(v * 60e6))
+	case "sec", "second":
+		i.Microseconds += int64(math.Round(v * 1e6))
+	case "msec", "millisecond":
+		i.Microseconds += int64(math.Round(v * 1e3))
+	case "usec", "microsecond":
+		i.Microseconds += int64(v)
+	default:
+		return fmt.Errorf("unknown interval unit %q", unit)
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package pginterval
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParse(t *testing.T) {
+	for _, tc := range []struct {
+		input  string
+		output Interval
+	}{
+		// postgres
+		{"1 year 2 mons 3 days 04:05:06.5", Interval{Months: 14, Days: 3, Microseconds: 14706500000}},
+		{"-1 days +02:00:00", Interval{Days: -1, Microseconds: 7200000000}},
+		{"00:00:00", Interval{}},
+		// postgres_verbose
+		{"@ 1 year 2 mons 3 days 4 hours 5 mins 6.5 secs ago", Interval{Months: -14, Days: -3, Microseconds: -14706500000}},
+		// sql_standard
+		{"1-2 3 4:05:06.5", Interval{Months: 14, Days: 3, Microseconds: 14706500000}},
+		{"-1-2", Interval{Months: -14}},
+		// iso_8601
+		{"P1Y2M3DT4H5M6.5S", Interval{Months: 14, Days: 3, Microseconds: 14706500000}},
+		{"PT-1.5S", Interval{Microseconds: -1500000}},
+	} {
+		got, err := Parse(tc.input)
+		if err != nil {
+			t.Errorf("%s: %s", tc.input, err)
+			continue
+		}
+		if diff := cmp.Diff(tc.output, got); diff != "" {
+			t.Errorf("%s: differed (-want +got):\n%s", tc.input, diff)
+		}
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, input := range []string{"1 fortnight", "P1X", "P1", "12:xx"} {
+		if _, err := Parse(input); err == nil {
+			t.Errorf("%s: expected an error", input)
+		}
+	}
+}